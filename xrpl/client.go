@@ -0,0 +1,306 @@
+package xrpl
+
+import (
+	"context"
+	"iter"
+
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/channel"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/clio"
+	cliotypes "github.com/Peersyst/xrpl-go/xrpl/queries/clio/types"
+	querycommon "github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/nft"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/oracle"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// SubmitOptions configures how a transaction is submitted: whether it's autofilled,
+// the wallet signing it if it isn't signed yet, and whether it's submitted with fail_hard.
+type SubmitOptions struct {
+	Autofill bool
+	Wallet   *wallet.Wallet
+	FailHard bool
+}
+
+// Client is the set of methods shared by the rpc and websocket clients.
+// Code written against it works with either transport, and it can be mocked in tests.
+type Client interface {
+	Querier
+	Paginator
+	LedgerEntryQuerier
+	ClioQuerier
+	Submitter
+
+	// FaucetProvider returns the configured faucet provider for the client.
+	FaucetProvider() common.FaucetProvider
+	// FundWallet funds a wallet using the configured faucet provider.
+	FundWallet(wallet *wallet.Wallet) error
+}
+
+// Querier is the set of query methods shared by the rpc and websocket clients.
+type Querier interface {
+	FindPathClose(req *path.FindCloseRequest) (*path.FindResponse, error)
+	FindPathCloseContext(ctx context.Context, req *path.FindCloseRequest) (*path.FindResponse, error)
+
+	FindPathCreate(req *path.FindCreateRequest) (*path.FindResponse, error)
+	FindPathCreateContext(ctx context.Context, req *path.FindCreateRequest) (*path.FindResponse, error)
+
+	FindPathStatus(req *path.FindStatusRequest) (*path.FindResponse, error)
+	FindPathStatusContext(ctx context.Context, req *path.FindStatusRequest) (*path.FindResponse, error)
+
+	GetAccountChannels(req *account.ChannelsRequest) (*account.ChannelsResponse, error)
+	GetAccountChannelsContext(ctx context.Context, req *account.ChannelsRequest) (*account.ChannelsResponse, error)
+
+	GetAccountCurrencies(req *account.CurrenciesRequest) (*account.CurrenciesResponse, error)
+	GetAccountCurrenciesContext(ctx context.Context, req *account.CurrenciesRequest) (*account.CurrenciesResponse, error)
+
+	GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse, error)
+	GetAccountInfoContext(ctx context.Context, req *account.InfoRequest) (*account.InfoResponse, error)
+
+	GetAccountLines(req *account.LinesRequest) (*account.LinesResponse, error)
+	GetAccountLinesContext(ctx context.Context, req *account.LinesRequest) (*account.LinesResponse, error)
+
+	GetAccountNFTs(req *account.NFTsRequest) (*account.NFTsResponse, error)
+	GetAccountNFTsContext(ctx context.Context, req *account.NFTsRequest) (*account.NFTsResponse, error)
+
+	GetAccountObjects(req *account.ObjectsRequest) (*account.ObjectsResponse, error)
+	GetAccountObjectsContext(ctx context.Context, req *account.ObjectsRequest) (*account.ObjectsResponse, error)
+
+	GetAccountOffers(req *account.OffersRequest) (*account.OffersResponse, error)
+	GetAccountOffersContext(ctx context.Context, req *account.OffersRequest) (*account.OffersResponse, error)
+
+	GetAccountTransactions(req *account.TransactionsRequest) (*account.TransactionsResponse, error)
+	GetAccountTransactionsContext(ctx context.Context, req *account.TransactionsRequest) (*account.TransactionsResponse, error)
+
+	GetAggregatePrice(req *oracle.GetAggregatePriceRequest) (*oracle.GetAggregatePriceResponse, error)
+	GetAggregatePriceContext(ctx context.Context, req *oracle.GetAggregatePriceRequest) (*oracle.GetAggregatePriceResponse, error)
+
+	GetAMMInfo(req *path.AMMInfoRequest) (*path.AMMInfoResponse, error)
+	GetAMMInfoContext(ctx context.Context, req *path.AMMInfoRequest) (*path.AMMInfoResponse, error)
+
+	GetAllFeatures(req *server.FeatureAllRequest) (*server.FeatureAllResponse, error)
+	GetAllFeaturesContext(ctx context.Context, req *server.FeatureAllRequest) (*server.FeatureAllResponse, error)
+
+	GetBookOffers(req *path.BookOffersRequest) (*path.BookOffersResponse, error)
+	GetBookOffersContext(ctx context.Context, req *path.BookOffersRequest) (*path.BookOffersResponse, error)
+
+	GetChannelVerify(req *channel.VerifyRequest) (*channel.VerifyResponse, error)
+	GetChannelVerifyContext(ctx context.Context, req *channel.VerifyRequest) (*channel.VerifyResponse, error)
+
+	GetClosedLedger() (*ledger.ClosedResponse, error)
+	GetClosedLedgerContext(ctx context.Context) (*ledger.ClosedResponse, error)
+
+	GetCurrentLedger() (*ledger.CurrentResponse, error)
+	GetCurrentLedgerContext(ctx context.Context) (*ledger.CurrentResponse, error)
+
+	GetDepositAuthorized(req *path.DepositAuthorizedRequest) (*path.DepositAuthorizedResponse, error)
+	GetDepositAuthorizedContext(ctx context.Context, req *path.DepositAuthorizedRequest) (*path.DepositAuthorizedResponse, error)
+
+	GetFeature(req *server.FeatureOneRequest) (*server.FeatureResponse, error)
+	GetFeatureContext(ctx context.Context, req *server.FeatureOneRequest) (*server.FeatureResponse, error)
+
+	GetFee(req *server.FeeRequest) (*server.FeeResponse, error)
+	GetFeeContext(ctx context.Context, req *server.FeeRequest) (*server.FeeResponse, error)
+
+	GetGatewayBalances(req *account.GatewayBalancesRequest) (*account.GatewayBalancesResponse, error)
+	GetGatewayBalancesContext(ctx context.Context, req *account.GatewayBalancesRequest) (*account.GatewayBalancesResponse, error)
+
+	GetLedger(req *ledger.Request) (*ledger.Response, error)
+	GetLedgerContext(ctx context.Context, req *ledger.Request) (*ledger.Response, error)
+
+	GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, error)
+	GetLedgerDataContext(ctx context.Context, req *ledger.DataRequest) (*ledger.DataResponse, error)
+
+	GetLedgerEntry(req *ledger.EntryRequest) (*ledger.EntryResponse, error)
+	GetLedgerEntryContext(ctx context.Context, req *ledger.EntryRequest) (*ledger.EntryResponse, error)
+
+	GetLedgerIndex() (querycommon.LedgerIndex, error)
+	GetLedgerIndexContext(ctx context.Context) (querycommon.LedgerIndex, error)
+
+	GetManifest(req *server.ManifestRequest) (*server.ManifestResponse, error)
+	GetManifestContext(ctx context.Context, req *server.ManifestRequest) (*server.ManifestResponse, error)
+
+	GetNFTBuyOffers(req *nft.NFTokenBuyOffersRequest) (*nft.NFTokenBuyOffersResponse, error)
+	GetNFTBuyOffersContext(ctx context.Context, req *nft.NFTokenBuyOffersRequest) (*nft.NFTokenBuyOffersResponse, error)
+
+	GetNFTSellOffers(req *nft.NFTokenSellOffersRequest) (*nft.NFTokenSellOffersResponse, error)
+	GetNFTSellOffersContext(ctx context.Context, req *nft.NFTokenSellOffersRequest) (*nft.NFTokenSellOffersResponse, error)
+
+	GetNoRippleCheck(req *account.NoRippleCheckRequest) (*account.NoRippleCheckResponse, error)
+	GetNoRippleCheckContext(ctx context.Context, req *account.NoRippleCheckRequest) (*account.NoRippleCheckResponse, error)
+
+	GetRandom(req *utility.RandomRequest) (*utility.RandomResponse, error)
+	GetRandomContext(ctx context.Context, req *utility.RandomRequest) (*utility.RandomResponse, error)
+
+	GetRipplePathFind(req *path.RipplePathFindRequest) (*path.RipplePathFindResponse, error)
+	GetRipplePathFindContext(ctx context.Context, req *path.RipplePathFindRequest) (*path.RipplePathFindResponse, error)
+
+	GetServerInfo(req *server.InfoRequest) (*server.InfoResponse, error)
+	GetServerInfoContext(ctx context.Context, req *server.InfoRequest) (*server.InfoResponse, error)
+
+	GetServerState(req *server.StateRequest) (*server.StateResponse, error)
+	GetServerStateContext(ctx context.Context, req *server.StateRequest) (*server.StateResponse, error)
+
+	GetTransaction(req *transactions.TxRequest) (*transactions.TxResponse, error)
+	GetTransactionContext(ctx context.Context, req *transactions.TxRequest) (*transactions.TxResponse, error)
+
+	GetTransactionEntry(req *transactions.EntryRequest) (*transactions.EntryResponse, error)
+	GetTransactionEntryContext(ctx context.Context, req *transactions.EntryRequest) (*transactions.EntryResponse, error)
+
+	GetXrpBalance(address types.Address) (string, error)
+	GetXrpBalanceContext(ctx context.Context, address types.Address) (string, error)
+
+	Ping(req *utility.PingRequest) (*utility.PingResponse, error)
+	PingContext(ctx context.Context, req *utility.PingRequest) (*utility.PingResponse, error)
+}
+
+// Paginator is the set of iterators over paged queries shared by the rpc and websocket clients.
+type Paginator interface {
+	AccountTransactionsAll(req *account.TransactionsRequest) iter.Seq2[account.Transaction, error]
+	AccountTransactionsAllContext(ctx context.Context, req *account.TransactionsRequest) iter.Seq2[account.Transaction, error]
+
+	LedgerDataAll(req *ledger.DataRequest) iter.Seq2[ledgertypes.State, error]
+	LedgerDataAllContext(ctx context.Context, req *ledger.DataRequest) iter.Seq2[ledgertypes.State, error]
+
+	AccountLinesAll(req *account.LinesRequest) iter.Seq2[accounttypes.TrustLine, error]
+	AccountLinesAllContext(ctx context.Context, req *account.LinesRequest) iter.Seq2[accounttypes.TrustLine, error]
+
+	AccountObjectsAll(req *account.ObjectsRequest) iter.Seq2[ledgerentry.FlatLedgerObject, error]
+	AccountObjectsAllContext(ctx context.Context, req *account.ObjectsRequest) iter.Seq2[ledgerentry.FlatLedgerObject, error]
+
+	AccountChannelsAll(req *account.ChannelsRequest) iter.Seq2[accounttypes.ChannelResult, error]
+	AccountChannelsAllContext(ctx context.Context, req *account.ChannelsRequest) iter.Seq2[accounttypes.ChannelResult, error]
+
+	AccountOffersAll(req *account.OffersRequest) iter.Seq2[accounttypes.OfferResult, error]
+	AccountOffersAllContext(ctx context.Context, req *account.OffersRequest) iter.Seq2[accounttypes.OfferResult, error]
+
+	AccountNFTsAll(req *account.NFTsRequest) iter.Seq2[accounttypes.NFT, error]
+	AccountNFTsAllContext(ctx context.Context, req *account.NFTsRequest) iter.Seq2[accounttypes.NFT, error]
+
+	NFTHistoryAll(req *clio.NFTHistoryRequest) iter.Seq2[clio.NFTHistoryTransactions, error]
+	NFTHistoryAllContext(ctx context.Context, req *clio.NFTHistoryRequest) iter.Seq2[clio.NFTHistoryTransactions, error]
+
+	NFTsByIssuerAll(req *clio.NFTsByIssuerRequest) iter.Seq2[cliotypes.NFToken, error]
+	NFTsByIssuerAllContext(ctx context.Context, req *clio.NFTsByIssuerRequest) iter.Seq2[cliotypes.NFToken, error]
+}
+
+// ClioQuerier is the set of Clio-only query methods shared by the rpc and websocket clients.
+// Each fails with ErrClioRequired of the engine package if the server isn't a Clio server.
+type ClioQuerier interface {
+	IsClio() (bool, error)
+	IsClioContext(ctx context.Context) (bool, error)
+
+	GetNFTInfo(req *clio.NFTInfoRequest) (*clio.NFTInfoResponse, error)
+	GetNFTInfoContext(ctx context.Context, req *clio.NFTInfoRequest) (*clio.NFTInfoResponse, error)
+
+	GetNFTHistory(req *clio.NFTHistoryRequest) (*clio.NFTHistoryResponse, error)
+	GetNFTHistoryContext(ctx context.Context, req *clio.NFTHistoryRequest) (*clio.NFTHistoryResponse, error)
+
+	GetNFTsByIssuer(req *clio.NFTsByIssuerRequest) (*clio.NFTsByIssuerResponse, error)
+	GetNFTsByIssuerContext(ctx context.Context, req *clio.NFTsByIssuerRequest) (*clio.NFTsByIssuerResponse, error)
+
+	GetClioLedgerIndex(req *clio.LedgerIndexRequest) (*clio.LedgerIndexResponse, error)
+	GetClioLedgerIndexContext(ctx context.Context, req *clio.LedgerIndexRequest) (*clio.LedgerIndexResponse, error)
+
+	GetMPTHolders(req *clio.MPTHoldersRequest) (*clio.MPTHoldersResponse, error)
+	GetMPTHoldersContext(ctx context.Context, req *clio.MPTHoldersRequest) (*clio.MPTHoldersResponse, error)
+}
+
+// LedgerEntryQuerier is the set of typed ledger_entry methods shared by the rpc and websocket clients.
+// Each returns the concrete ledger-entry-types struct of the entry.
+type LedgerEntryQuerier interface {
+	GetAccountRoot(req *ledger.EntryRequest) (*ledgerentry.AccountRoot, error)
+	GetAccountRootContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.AccountRoot, error)
+
+	GetRippleState(req *ledger.EntryRequest) (*ledgerentry.RippleState, error)
+	GetRippleStateContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.RippleState, error)
+
+	GetOffer(req *ledger.EntryRequest) (*ledgerentry.Offer, error)
+	GetOfferContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Offer, error)
+
+	GetEscrow(req *ledger.EntryRequest) (*ledgerentry.Escrow, error)
+	GetEscrowContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Escrow, error)
+
+	GetPaymentChannel(req *ledger.EntryRequest) (*ledgerentry.PayChannel, error)
+	GetPaymentChannelContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.PayChannel, error)
+
+	GetCheck(req *ledger.EntryRequest) (*ledgerentry.Check, error)
+	GetCheckContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Check, error)
+
+	GetTicket(req *ledger.EntryRequest) (*ledgerentry.Ticket, error)
+	GetTicketContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Ticket, error)
+
+	GetNFTPage(req *ledger.EntryRequest) (*ledgerentry.NFTokenPage, error)
+	GetNFTPageContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.NFTokenPage, error)
+
+	GetAMM(req *ledger.EntryRequest) (*ledgerentry.AMM, error)
+	GetAMMContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.AMM, error)
+
+	GetDID(req *ledger.EntryRequest) (*ledgerentry.DID, error)
+	GetDIDContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.DID, error)
+
+	GetOracle(req *ledger.EntryRequest) (*ledgerentry.Oracle, error)
+	GetOracleContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Oracle, error)
+
+	GetCredential(req *ledger.EntryRequest) (*ledgerentry.Credential, error)
+	GetCredentialContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Credential, error)
+
+	GetMPTIssuance(req *ledger.EntryRequest) (*ledgerentry.MPTokenIssuance, error)
+	GetMPTIssuanceContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.MPTokenIssuance, error)
+
+	GetMPToken(req *ledger.EntryRequest) (*ledgerentry.MPToken, error)
+	GetMPTokenContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.MPToken, error)
+
+	GetPermissionedDomain(req *ledger.EntryRequest) (*ledgerentry.PermissionedDomain, error)
+	GetPermissionedDomainContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.PermissionedDomain, error)
+
+	GetBridge(req *ledger.EntryRequest) (*ledgerentry.Bridge, error)
+	GetBridgeContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Bridge, error)
+
+	GetXChainOwnedClaimID(req *ledger.EntryRequest) (*ledgerentry.XChainOwnedClaimID, error)
+	GetXChainOwnedClaimIDContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.XChainOwnedClaimID, error)
+}
+
+// Submitter is the set of autofill, submit, simulate and wait methods shared by the rpc and websocket clients.
+type Submitter interface {
+	Autofill(tx *transaction.FlatTransaction) error
+	AutofillContext(ctx context.Context, tx *transaction.FlatTransaction) error
+
+	AutofillMultisigned(tx *transaction.FlatTransaction, nSigners uint64) error
+	AutofillMultisignedContext(ctx context.Context, tx *transaction.FlatTransaction, nSigners uint64) error
+
+	SubmitTxBlob(txBlob string, failHard bool) (*transactions.SubmitResponse, error)
+	SubmitTxBlobContext(ctx context.Context, txBlob string, failHard bool) (*transactions.SubmitResponse, error)
+
+	SubmitTx(tx transaction.FlatTransaction, opts *SubmitOptions) (*transactions.SubmitResponse, error)
+	SubmitTxContext(ctx context.Context, tx transaction.FlatTransaction, opts *SubmitOptions) (*transactions.SubmitResponse, error)
+
+	SubmitMultisigned(txBlob string, failHard bool) (*transactions.SubmitMultisignedResponse, error)
+	SubmitMultisignedContext(ctx context.Context, txBlob string, failHard bool) (*transactions.SubmitMultisignedResponse, error)
+
+	Simulate(tx transaction.FlatTransaction, autofill bool) (*transactions.SimulateResponse, error)
+	SimulateContext(ctx context.Context, tx transaction.FlatTransaction, autofill bool) (*transactions.SimulateResponse, error)
+
+	SubmitTxBlobAndWait(txBlob string, failHard bool) (*transactions.TxResponse, error)
+	SubmitTxBlobAndWaitContext(ctx context.Context, txBlob string, failHard bool) (*transactions.TxResponse, error)
+
+	SubmitTxAndWait(tx transaction.FlatTransaction, opts *SubmitOptions) (*transactions.TxResponse, error)
+	SubmitTxAndWaitContext(ctx context.Context, tx transaction.FlatTransaction, opts *SubmitOptions) (*transactions.TxResponse, error)
+
+	SubmitTxBlobReliable(txBlob string, failHard bool) (*SubmissionResult, error)
+	SubmitTxBlobReliableContext(ctx context.Context, txBlob string, failHard bool) (*SubmissionResult, error)
+
+	SubmitTxReliable(tx transaction.FlatTransaction, opts *SubmitOptions) (*SubmissionResult, error)
+	SubmitTxReliableContext(ctx context.Context, tx transaction.FlatTransaction, opts *SubmitOptions) (*SubmissionResult, error)
+}
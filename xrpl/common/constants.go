@@ -15,11 +15,29 @@ const (
 	DefaultMaxReconnects = 3
 	// DefaultRetryDelay is the default delay between retry attempts.
 	DefaultRetryDelay = 1 * time.Second
+	// DefaultMaxRequestRetries is the default maximum number of retries of a failed RPC request.
+	DefaultMaxRequestRetries = 3
+	// DefaultMaxRetryDelay is the default maximum delay between retries of a failed RPC request.
+	DefaultMaxRetryDelay = 10 * time.Second
+	// DefaultReconnectBackoff is the default delay before the first websocket reconnect attempt.
+	DefaultReconnectBackoff = 500 * time.Millisecond
+	// DefaultMaxReconnectBackoff is the default maximum delay between websocket reconnect attempts.
+	DefaultMaxReconnectBackoff = 30 * time.Second
+	// DefaultStreamBufferSize is the default number of messages buffered for each websocket stream handler.
+	DefaultStreamBufferSize = 256
 	// DefaultFeeCushion is the default fee cushion multiplier.
 	DefaultFeeCushion float32 = 1.2
 	// DefaultMaxFeeXRP is the default maximum fee in XRP.
 	DefaultMaxFeeXRP float32 = 2
 
+	// DefaultBatchConcurrency is the default number of requests of a batch sent concurrently by the RPC client.
+	DefaultBatchConcurrency = 8
+
 	// DefaultTimeout is the default timeout for RPC calls (5 seconds).
 	DefaultTimeout = 5 * time.Second
+
+	// DefaultHealthCheckInterval is the default interval between health checks of pool endpoints.
+	DefaultHealthCheckInterval = 10 * time.Second
+	// DefaultMaxLedgerAge is the default maximum age of the last validated ledger of a healthy pool endpoint.
+	DefaultMaxLedgerAge = 30 * time.Second
 )
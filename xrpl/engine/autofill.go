@@ -0,0 +1,428 @@
+package engine
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+const (
+	// RestrictedNetworks is the minimum network ID above which networks are considered restricted.
+	// Sidechains are expected to have network IDs above this.
+	// Networks with ID above this restricted number are expected to specify an accurate NetworkID field
+	// in every transaction to that chain to prevent replay attacks.
+	// Mainnet and testnet are exceptions. More context: https://github.com/XRPLF/rippled/pull/4370
+	RestrictedNetworks = 1024
+	// RequiredNetworkIDVersion is the minimum rippled version that requires NetworkID validation.
+	RequiredNetworkIDVersion = "1.11.0"
+)
+
+// Autofill fills in the missing fields in a transaction.
+func (e *Engine) Autofill(ctx context.Context, tx *transaction.FlatTransaction) error {
+	if err := setValidTransactionAddresses(tx); err != nil {
+		return err
+	}
+
+	err := setTransactionFlags(tx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := (*tx)["NetworkID"]; !ok {
+		if networkID := e.networkID(); networkID != 0 {
+			(*tx)["NetworkID"] = networkID
+			e.cfg.Logger.DebugContext(ctx, "autofilled NetworkID", "network_id", networkID)
+		}
+	}
+	if _, ok := (*tx)["Sequence"]; !ok {
+		err := e.SetNextValidSequenceNumber(ctx, tx)
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := (*tx)["Fee"]; !ok {
+		err := e.CalculateFee(ctx, tx, 0)
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := (*tx)["LastLedgerSequence"]; !ok {
+		err := e.SetLastLedgerSequence(ctx, tx)
+		if err != nil {
+			return err
+		}
+	}
+	if txType, ok := (*tx)["TransactionType"].(string); ok {
+		if acc, ok := (*tx)["Account"].(types.Address); txType == transaction.AccountDeleteTx.String() && ok {
+			err := e.CheckAccountDeleteBlockers(ctx, acc)
+			if err != nil {
+				return err
+			}
+		}
+		if txType == transaction.PaymentTx.String() {
+			err := checkPaymentAmounts(tx)
+			if err != nil {
+				return err
+			}
+		}
+		if txType == transaction.BatchTx.String() {
+			err := e.AutofillRawTransactions(ctx, tx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AutofillMultisigned fills in the missing fields in a multisigned transaction.
+// It fills in the missing fields in the transaction and calculates the fee per number of signers.
+func (e *Engine) AutofillMultisigned(ctx context.Context, tx *transaction.FlatTransaction, nSigners uint64) error {
+	err := e.Autofill(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	return e.CalculateFee(ctx, tx, nSigners)
+}
+
+// SetNextValidSequenceNumber sets the next valid sequence number of the transaction's account.
+// With a sequence manager, the Sequence or a TicketSequence is handed out by the manager instead.
+func (e *Engine) SetNextValidSequenceNumber(ctx context.Context, tx *transaction.FlatTransaction) error {
+	if _, ok := (*tx)["Account"].(string); !ok {
+		return ErrMissingAccountInTransaction
+	}
+	if e.cfg.Sequences != nil {
+		return e.setManagedSequence(ctx, tx, types.Address((*tx)["Account"].(string)))
+	}
+	res, err := e.getAccountInfo(ctx, &account.InfoRequest{
+		Account:     types.Address((*tx)["Account"].(string)),
+		LedgerIndex: common.LedgerTitle("current"),
+	})
+	if err != nil {
+		return err
+	}
+
+	(*tx)["Sequence"] = uint32(res.AccountData.Sequence)
+	e.cfg.Logger.DebugContext(ctx, "autofilled Sequence", "account", res.AccountData.Account, "sequence", res.AccountData.Sequence)
+	return nil
+}
+
+// SetLastLedgerSequence sets the LastLedgerSequence of the transaction to the latest validated ledger
+// sequence plus the ledger offset.
+func (e *Engine) SetLastLedgerSequence(ctx context.Context, tx *transaction.FlatTransaction) error {
+	index, err := e.getLedgerIndex(ctx)
+	if err != nil {
+		return err
+	}
+
+	(*tx)["LastLedgerSequence"] = index.Uint32() + commonconstants.LedgerOffset
+	e.cfg.Logger.DebugContext(ctx, "autofilled LastLedgerSequence",
+		"validated_ledger", index.Uint32(),
+		"last_ledger_sequence", (*tx)["LastLedgerSequence"],
+	)
+	return nil
+}
+
+// CheckAccountDeleteBlockers checks for any blockers that prevent the deletion of an account.
+// Returns nil if there are no blockers, otherwise returns an error.
+func (e *Engine) CheckAccountDeleteBlockers(ctx context.Context, address types.Address) error {
+	accObjects, err := e.getAccountObjects(ctx, &account.ObjectsRequest{
+		Account:              address,
+		LedgerIndex:          common.LedgerTitle("validated"),
+		DeletionBlockersOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(accObjects.AccountObjects) > 0 {
+		return ErrAccountCannotBeDeleted
+	}
+	return nil
+}
+
+// AutofillRawTransactions fills in the missing fields of the inner transactions of a Batch transaction
+// and checks that they are not signed.
+func (e *Engine) AutofillRawTransactions(ctx context.Context, tx *transaction.FlatTransaction) error {
+	needsNetworkID, err := e.TxNeedsNetworkID(ctx)
+	if err != nil {
+		return err
+	}
+
+	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
+	if !ok {
+		return ErrRawTransactionsFieldIsNotAnArray
+	}
+
+	accountSeq := make(map[string]uint32, len(rawTxs))
+
+	for _, rawTx := range rawTxs {
+		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
+		if !ok {
+			return ErrRawTransactionFieldIsNotAnObject
+		}
+
+		// Validate `Fee` field
+		if innerRawTx["Fee"] == nil {
+			innerRawTx["Fee"] = "0"
+		} else if innerRawTx["Fee"] != "0" {
+			return types.ErrBatchInnerTransactionInvalid
+		}
+
+		// Validate `SigningPubKey` field
+		if innerRawTx["SigningPubKey"] == nil {
+			innerRawTx["SigningPubKey"] = ""
+		} else if innerRawTx["SigningPubKey"] != "" {
+			return ErrSigningPubKeyFieldMustBeEmpty
+		}
+
+		// Validate `TxnSignature` field
+		if innerRawTx["TxnSignature"] != nil {
+			return ErrTxnSignatureFieldMustBeEmpty
+		}
+		if innerRawTx["Signers"] != nil {
+			return ErrSignersFieldMustBeEmpty
+		}
+
+		// Validate `NetworkID` field
+		if innerRawTx["NetworkID"] == nil && needsNetworkID {
+			innerRawTx["NetworkID"] = e.networkID()
+		}
+
+		// Validate `Sequence` field
+		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
+			acc, ok := innerRawTx["Account"].(string)
+			if !ok {
+				return ErrAccountFieldIsNotAString
+			}
+
+			if accountSeq[acc] != 0 {
+				innerRawTx["Sequence"] = accountSeq[acc]
+				accountSeq[acc]++
+			} else {
+				accountInfo, err := e.getAccountInfo(ctx, &account.InfoRequest{
+					Account: types.Address(acc),
+				})
+				if err != nil {
+					return err
+				}
+				var seq uint32
+				if innerRawTx["Account"] == (*tx)["Account"] {
+					seq = accountInfo.AccountData.Sequence + 1
+				} else {
+					seq = accountInfo.AccountData.Sequence
+				}
+				accountSeq[acc] = seq + 1
+				innerRawTx["Sequence"] = seq
+			}
+		}
+	}
+
+	return nil
+}
+
+// TxNeedsNetworkID determines if the transaction required a networkID to be valid.
+// Transaction needs networkID if later than restricted ID and build version is >= 1.11.0
+func (e *Engine) TxNeedsNetworkID(ctx context.Context) (bool, error) {
+	if networkID := e.networkID(); networkID != 0 && networkID > RestrictedNetworks {
+		res, err := e.getServerInfo(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if res.Info.BuildVersion != "" {
+			return isNotLaterRippledVersion(RequiredNetworkIDVersion, res.Info.BuildVersion), nil
+		}
+	}
+	return false, nil
+}
+
+// Sets valid addresses for the transaction.
+func setValidTransactionAddresses(tx *transaction.FlatTransaction) error {
+	// Validate if "Account" address is an xAddress
+	if err := validateTransactionAddress(tx, "Account", "SourceTag"); err != nil {
+		return err
+	}
+
+	if _, ok := (*tx)["Destination"]; ok {
+		if err := validateTransactionAddress(tx, "Destination", "DestinationTag"); err != nil {
+			return err
+		}
+	}
+
+	// DepositPreuaht
+	convertTransactionAddressToClassicAddress(tx, "Authorize")
+	convertTransactionAddressToClassicAddress(tx, "Unauthorize")
+	// EscrowCancel, EscrowFinish
+	convertTransactionAddressToClassicAddress(tx, "Owner")
+	// SetRegularKey
+	convertTransactionAddressToClassicAddress(tx, "RegularKey")
+
+	return nil
+}
+
+// TODO: Implement this when IsValidXAddress is implemented
+func getClassicAccountAndTag(address string) (string, uint32) {
+	return address, 0
+}
+
+func convertTransactionAddressToClassicAddress(tx *transaction.FlatTransaction, fieldName string) {
+	if address, ok := (*tx)[fieldName].(string); ok {
+		classicAddress, _ := getClassicAccountAndTag(address)
+		(*tx)[fieldName] = classicAddress
+	}
+}
+
+func validateTransactionAddress(tx *transaction.FlatTransaction, addressField, tagField string) error {
+	classicAddress, tag := getClassicAccountAndTag((*tx)[addressField].(string))
+	(*tx)[addressField] = classicAddress
+
+	if tag != uint32(0) {
+		if txTag, ok := (*tx)[tagField].(uint32); ok && txTag != tag {
+			return ErrMismatchedTag{
+				Expected: addressField,
+				Actual:   tagField,
+			}
+		}
+		(*tx)[tagField] = tag
+	}
+
+	return nil
+}
+
+// Sets a transaction's flags to its numeric representation.
+// TODO: Add flag support for AMMDeposit, AMMWithdraw,
+// NFTTOkenCreateOffer, NFTokenMint, OfferCreate, XChainModifyBridge (not supported).
+func setTransactionFlags(tx *transaction.FlatTransaction) error {
+	flags, ok := (*tx)["Flags"].(uint32)
+	if !ok && flags > 0 {
+		(*tx)["Flags"] = int(0)
+		return nil
+	}
+
+	_, ok = (*tx)["TransactionType"].(string)
+	if !ok {
+		return ErrTransactionTypeMissing
+	}
+
+	return nil
+}
+
+func checkPaymentAmounts(tx *transaction.FlatTransaction) error {
+	if _, ok := (*tx)["DeliverMax"]; ok {
+		if _, ok := (*tx)["Amount"]; !ok {
+			(*tx)["Amount"] = (*tx)["DeliverMax"]
+		} else if (*tx)["Amount"] != (*tx)["DeliverMax"] {
+			return ErrAmountAndDeliverMaxMustBeIdentical
+		}
+	}
+	return nil
+}
+
+// isNotLaterRippledVersion determines whether the source rippled version is not later than the target rippled version.
+// Example usage: isNotLaterRippledVersion("1.10.0", "1.11.0") returns true.
+//
+//	isNotLaterRippledVersion("1.10.0", "1.10.0-b1") returns false.
+func isNotLaterRippledVersion(source, target string) bool {
+	if source == target {
+		return true
+	}
+
+	sourceDecomp := strings.Split(source, ".")
+	targetDecomp := strings.Split(target, ".")
+
+	if len(sourceDecomp) < 3 || len(targetDecomp) < 3 {
+		return false
+	}
+
+	sourceMajor, err := strconv.Atoi(sourceDecomp[0])
+	if err != nil {
+		return false
+	}
+	sourceMinor, err := strconv.Atoi(sourceDecomp[1])
+	if err != nil {
+		return false
+	}
+	targetMajor, err := strconv.Atoi(targetDecomp[0])
+	if err != nil {
+		return false
+	}
+	targetMinor, err := strconv.Atoi(targetDecomp[1])
+	if err != nil {
+		return false
+	}
+
+	// Compare major version
+	if sourceMajor != targetMajor {
+		return sourceMajor < targetMajor
+	}
+
+	// Compare minor version
+	if sourceMinor != targetMinor {
+		return sourceMinor < targetMinor
+	}
+
+	sourcePatch := strings.Split(sourceDecomp[2], "-")
+	targetPatch := strings.Split(targetDecomp[2], "-")
+
+	sourcePatchVersion, err := strconv.Atoi(sourcePatch[0])
+	if err != nil {
+		return false
+	}
+	targetPatchVersion, err := strconv.Atoi(targetPatch[0])
+	if err != nil {
+		return false
+	}
+
+	// Compare patch version
+	if sourcePatchVersion != targetPatchVersion {
+		return sourcePatchVersion < targetPatchVersion
+	}
+
+	// Compare release version
+	if len(sourcePatch) != len(targetPatch) {
+		return len(sourcePatch) > len(targetPatch)
+	}
+
+	if len(sourcePatch) == 2 {
+		// Compare different release types
+		if !strings.HasPrefix(sourcePatch[1], string(targetPatch[1][0])) {
+			return sourcePatch[1] < targetPatch[1]
+		}
+
+		// Compare beta version
+		if strings.HasPrefix(sourcePatch[1], "b") {
+			sourceBeta, err := strconv.Atoi(sourcePatch[1][1:])
+			if err != nil {
+				return false
+			}
+			targetBeta, err := strconv.Atoi(targetPatch[1][1:])
+			if err != nil {
+				return false
+			}
+			return sourceBeta < targetBeta
+		}
+
+		// Compare rc version
+		if strings.HasPrefix(sourcePatch[1], "rc") {
+			sourceRC, err := strconv.Atoi(sourcePatch[1][2:])
+			if err != nil {
+				return false
+			}
+			targetRC, err := strconv.Atoi(targetPatch[1][2:])
+			if err != nil {
+				return false
+			}
+			return sourceRC < targetRC
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,214 @@
+package engine
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+func TestConvertTransactionAddressToClassicAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		tx        transaction.FlatTransaction
+		fieldName string
+		expected  transaction.FlatTransaction
+	}{
+		{
+			name: "No conversion for classic address",
+			tx: transaction.FlatTransaction{
+				"Destination": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			},
+			fieldName: "Destination",
+			expected: transaction.FlatTransaction{
+				"Destination": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			},
+		},
+		{
+			name: "Field not present in transaction",
+			tx: transaction.FlatTransaction{
+				"Amount": "1000000",
+			},
+			fieldName: "Destination",
+			expected: transaction.FlatTransaction{
+				"Amount": "1000000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			convertTransactionAddressToClassicAddress(&tt.tx, tt.fieldName)
+			if reflect.DeepEqual(tt.expected, &tt.tx) {
+				t.Errorf("expected %+v, result %+v", tt.expected, &tt.tx)
+			}
+		})
+	}
+}
+
+func TestValidateTransactionAddress(t *testing.T) {
+	tests := []struct {
+		name         string
+		tx           transaction.FlatTransaction
+		addressField string
+		tagField     string
+		expected     transaction.FlatTransaction
+		expectedErr  error
+	}{
+		{
+			name: "Valid classic address without tag",
+			tx: transaction.FlatTransaction{
+				"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			},
+			addressField: "Account",
+			tagField:     "SourceTag",
+			expected: transaction.FlatTransaction{
+				"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Valid classic address with tag",
+			tx: transaction.FlatTransaction{
+				"Destination":    "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"DestinationTag": uint32(12345),
+			},
+			addressField: "Destination",
+			tagField:     "DestinationTag",
+			expected: transaction.FlatTransaction{
+				"Destination":    "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"DestinationTag": uint32(12345),
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTransactionAddress(&tt.tx, tt.addressField, tt.tagField)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, tt.tx) {
+				t.Errorf("Expected %v, but got %v", tt.expected, tt.tx)
+			}
+		})
+	}
+}
+
+func TestSetValidTransactionAddresses(t *testing.T) {
+	tests := []struct {
+		name        string
+		tx          transaction.FlatTransaction
+		expected    transaction.FlatTransaction
+		expectedErr error
+	}{
+		{
+			name: "Valid transaction with classic addresses",
+			tx: transaction.FlatTransaction{
+				"Account":     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			},
+			expected: transaction.FlatTransaction{
+				"Account":     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Transaction with additional address fields",
+			tx: transaction.FlatTransaction{
+				"Account":     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Owner":       "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"RegularKey":  "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			},
+			expected: transaction.FlatTransaction{
+				"Account":     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Owner":       "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"RegularKey":  "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := setValidTransactionAddresses(&tt.tx)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, tt.tx) {
+				t.Errorf("Expected %v, but got %v", tt.expected, tt.tx)
+			}
+		})
+	}
+}
+
+func TestSetTransactionFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		tx       transaction.FlatTransaction
+		expected uint32
+		wantErr  bool
+	}{
+		{
+			name: "No flags set",
+			tx: transaction.FlatTransaction{
+				"TransactionType": string(transaction.PaymentTx),
+			},
+			expected: uint32(0),
+			wantErr:  false,
+		},
+		{
+			name: "Flags already set",
+			tx: transaction.FlatTransaction{
+				"TransactionType": string(transaction.PaymentTx),
+				"Flags":           uint32(1),
+			},
+			expected: 1,
+			wantErr:  false,
+		},
+		{
+			name: "Missing TransactionType",
+			tx: transaction.FlatTransaction{
+				"Flags": uint32(1),
+			},
+			expected: 0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := setTransactionFlags(&tt.tx)
+
+			if (err != nil) != tt.wantErr {
+
+				t.Errorf("setTransactionFlags() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				flags, ok := tt.tx["Flags"]
+				if !ok && tt.expected != 0 {
+					t.Errorf("setTransactionFlags() got = %v (type %T), want %v (type %T)", flags, flags, tt.expected, tt.expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ClioProbe caches whether the server of a client is a Clio server, so that it's detected with a single
+// server_info request. The zero value is ready to use, and a ClioProbe is safe for concurrent use.
+type ClioProbe struct {
+	// detecting serializes the detections, so that concurrent callers share a single request.
+	detecting sync.Mutex
+
+	mu    sync.Mutex
+	known bool
+	clio  bool
+	// resets counts the calls to Reset, so that a detection overlapping a Reset isn't cached.
+	resets uint64
+}
+
+// Reset forgets the cached result, so the server is detected again the next time it's needed.
+// It may be called while a detection is running, including from the request of the detection.
+func (p *ClioProbe) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.known = false
+	p.resets++
+}
+
+// detect returns the cached result, detecting it with detect if it isn't cached. Failed detections aren't cached.
+func (p *ClioProbe) detect(ctx context.Context, detect func(context.Context) (bool, error)) (bool, error) {
+	p.detecting.Lock()
+	defer p.detecting.Unlock()
+
+	p.mu.Lock()
+	known, clio, resets := p.known, p.clio, p.resets
+	p.mu.Unlock()
+	if known {
+		return clio, nil
+	}
+
+	clio, err := detect(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.resets == resets {
+		p.known, p.clio = true, clio
+	}
+	return clio, nil
+}
+
+// IsClio reports whether the server is a Clio server, which is the case when its server_info includes a
+// clio_version.
+func (e *Engine) IsClio(ctx context.Context) (bool, error) {
+	detect := func(ctx context.Context) (bool, error) {
+		res, err := e.getServerInfo(ctx)
+		if err != nil {
+			return false, err
+		}
+		e.cfg.Logger.DebugContext(ctx, "detected server type", "clio_version", res.Info.ClioVersion)
+		return res.Info.ClioVersion != "", nil
+	}
+	if e.cfg.Clio == nil {
+		return detect(ctx)
+	}
+	return e.cfg.Clio.detect(ctx, detect)
+}
+
+// RequireClio returns ErrClioRequired, wrapped with the name of the method, if the server isn't a Clio server.
+func (e *Engine) RequireClio(ctx context.Context, method string) error {
+	clio, err := e.IsClio(ctx)
+	if err != nil {
+		return err
+	}
+	if !clio {
+		return fmt.Errorf("%w: %s", ErrClioRequired, method)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_RequireClio(t *testing.T) {
+	clioInfo := mockResponse{result: map[string]any{"info": map[string]any{"clio_version": "2.3.0"}}}
+	rippledInfo := mockResponse{result: map[string]any{"info": map[string]any{"build_version": "2.3.0"}}}
+
+	tests := []struct {
+		name        string
+		responses   []mockResponse
+		expectedErr error
+	}{
+		{
+			name:      "pass - Clio server",
+			responses: []mockResponse{clioInfo},
+		},
+		{
+			name:        "fail - rippled server",
+			responses:   []mockResponse{rippledInfo},
+			expectedErr: ErrClioRequired,
+		},
+		{
+			name:        "fail - server_info error",
+			responses:   []mockResponse{{err: errors.New("tooBusy")}},
+			expectedErr: errors.New("tooBusy"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := &mockExecutor{responses: map[string][]mockResponse{"server_info": tt.responses}}
+			e := New(exec, Config{})
+
+			err := e.RequireClio(context.Background(), "nft_info")
+			switch {
+			case tt.expectedErr == nil:
+				require.NoError(t, err)
+			case errors.Is(tt.expectedErr, ErrClioRequired):
+				require.ErrorIs(t, err, ErrClioRequired)
+				require.EqualError(t, err, "method is only available on Clio servers: nft_info")
+			default:
+				require.EqualError(t, err, tt.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestClioProbe(t *testing.T) {
+	exec := &mockExecutor{responses: map[string][]mockResponse{"server_info": {
+		{err: errors.New("tooBusy")},
+		{result: map[string]any{"info": map[string]any{"clio_version": "2.3.0"}}},
+		{result: map[string]any{"info": map[string]any{"build_version": "2.3.0"}}},
+	}}}
+	probe := &ClioProbe{}
+	e := New(exec, Config{Clio: probe})
+
+	// Failed detections aren't cached.
+	_, err := e.IsClio(context.Background())
+	require.EqualError(t, err, "tooBusy")
+
+	for range 2 {
+		clio, err := e.IsClio(context.Background())
+		require.NoError(t, err)
+		require.True(t, clio)
+	}
+	require.Equal(t, []string{"server_info", "server_info"}, exec.calls)
+
+	probe.Reset()
+	clio, err := e.IsClio(context.Background())
+	require.NoError(t, err)
+	require.False(t, clio)
+	require.Len(t, exec.calls, 3)
+}
+
+func TestClioProbe_ResetDuringDetection(t *testing.T) {
+	probe := &ClioProbe{}
+	calls := 0
+	detect := func(context.Context) (bool, error) {
+		calls++
+		if calls == 1 {
+			// The server changed while it was being detected.
+			probe.Reset()
+		}
+		return true, nil
+	}
+
+	for range 3 {
+		clio, err := probe.detect(context.Background(), detect)
+		require.NoError(t, err)
+		require.True(t, clio)
+	}
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,121 @@
+// Package engine implements the transport-independent part of autofilling, signing, submitting and
+// waiting for XRPL transactions, and of iterating over paged queries. The rpc and websocket clients
+// delegate to it, and any other transport gets the same behaviour by implementing Executor.
+package engine
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+)
+
+// Request is a request that can be sent to an XRPL server.
+// It is implemented by every request type in the queries packages.
+type Request interface {
+	Method() string
+	Validate() error
+	APIVersion() int
+	SetAPIVersion(apiVersion int)
+}
+
+// Executor sends requests to an XRPL server.
+type Executor interface {
+	// Execute sends the request and decodes the result of the response into result.
+	// An error response from the server must be returned as an *xrpl.XRPLError.
+	Execute(ctx context.Context, req Request, result any) error
+}
+
+// Config holds the fee, retry and network settings used by an Engine.
+type Config struct {
+	// FeeCushion multiplies the network fee to cope with fee escalation between autofill and submission.
+	FeeCushion float32
+	// MaxFeeXRP caps the autofilled fee, except for transactions whose cost is the owner reserve.
+	MaxFeeXRP float32
+	// MaxRetries is the maximum number of times the transaction is looked up while waiting for it.
+	MaxRetries int
+	// RetryDelay is the delay between two lookups of the transaction while waiting for it.
+	RetryDelay time.Duration
+	// NetworkID returns the network ID set on autofilled transactions when it's not zero. It's called for
+	// every transaction, so that the clients can expose it as a field. No network ID is set when it's nil.
+	NetworkID func() uint32
+	// Sequences hands out the Sequence of autofilled transactions when it's not nil. Otherwise the
+	// Sequence is fetched with account_info for every transaction.
+	Sequences *SequenceManager
+	// Clio caches whether the server is a Clio server when it's not nil. Otherwise it's detected with
+	// server_info every time it's needed.
+	Clio *ClioProbe
+	// Logger receives the fee calculations, autofill decisions and transaction lookups of the engine.
+	// Nothing is logged when it's nil.
+	Logger *slog.Logger
+}
+
+// Engine autofills, signs, submits and waits for transactions, and iterates over paged queries, sending its
+// requests through an Executor.
+type Engine struct {
+	exec Executor
+	cfg  Config
+}
+
+// New creates an Engine sending its requests through exec.
+func New(exec Executor, cfg Config) *Engine {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.New(slog.DiscardHandler)
+	}
+	return &Engine{
+		exec: exec,
+		cfg:  cfg,
+	}
+}
+
+// networkID returns the network ID set on autofilled transactions, or zero if there is none.
+func (e *Engine) networkID() uint32 {
+	if e.cfg.NetworkID == nil {
+		return 0
+	}
+	return e.cfg.NetworkID()
+}
+
+// execute sends the request through the executor and returns its decoded result.
+func execute[T any](ctx context.Context, exec Executor, req Request) (*T, error) {
+	var res T
+	if err := exec.Execute(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (e *Engine) getAccountInfo(ctx context.Context, req *account.InfoRequest) (*account.InfoResponse, error) {
+	return execute[account.InfoResponse](ctx, e.exec, req)
+}
+
+func (e *Engine) getAccountObjects(ctx context.Context, req *account.ObjectsRequest) (*account.ObjectsResponse, error) {
+	return execute[account.ObjectsResponse](ctx, e.exec, req)
+}
+
+func (e *Engine) getLedgerEntry(ctx context.Context, req *ledger.EntryRequest) (*ledger.EntryResponse, error) {
+	return execute[ledger.EntryResponse](ctx, e.exec, req)
+}
+
+func (e *Engine) getServerInfo(ctx context.Context) (*server.InfoResponse, error) {
+	return execute[server.InfoResponse](ctx, e.exec, &server.InfoRequest{})
+}
+
+func (e *Engine) getServerState(ctx context.Context) (*server.StateResponse, error) {
+	return execute[server.StateResponse](ctx, e.exec, &server.StateRequest{})
+}
+
+// getLedgerIndex returns the index of the last validated ledger.
+func (e *Engine) getLedgerIndex(ctx context.Context) (common.LedgerIndex, error) {
+	res, err := execute[ledger.Response](ctx, e.exec, &ledger.Request{
+		LedgerIndex: common.LedgerTitle("validated"),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.LedgerIndex, nil
+}
@@ -0,0 +1,521 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
+	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/require"
+)
+
+type mockResponse struct {
+	result map[string]any
+	err    error
+}
+
+// mockExecutor answers every request with the next response queued for its method.
+// The last response of a method is repeated once the queue is exhausted.
+type mockExecutor struct {
+	responses map[string][]mockResponse
+	calls     []string
+}
+
+func (m *mockExecutor) Execute(_ context.Context, req Request, result any) error {
+	m.calls = append(m.calls, req.Method())
+
+	queue := m.responses[req.Method()]
+	if len(queue) == 0 {
+		return errors.New("unexpected request: " + req.Method())
+	}
+	res := queue[0]
+	if len(queue) > 1 {
+		m.responses[req.Method()] = queue[1:]
+	}
+	if res.err != nil {
+		return res.err
+	}
+
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{TagName: "json", Result: result, DecodeHook: mapstructure.TextUnmarshallerHookFunc()})
+	if err != nil {
+		return err
+	}
+	return dec.Decode(res.result)
+}
+
+func serverInfoResponse(baseFeeXRP float32, loadFactor uint) mockResponse {
+	return mockResponse{result: map[string]any{
+		"info": map[string]any{
+			"validated_ledger": map[string]any{
+				"base_fee_xrp": baseFeeXRP,
+			},
+			"load_factor": loadFactor,
+		},
+	}}
+}
+
+func ledgerResponse(index uint32) mockResponse {
+	return mockResponse{result: map[string]any{"ledger_index": index}}
+}
+
+func TestEngine_Autofill(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         Config
+		tx          transaction.FlatTransaction
+		responses   map[string][]mockResponse
+		expected    transaction.FlatTransaction
+		expectedErr error
+	}{
+		{
+			name: "pass - fills sequence, fee and last ledger sequence",
+			cfg:  Config{FeeCushion: 1, MaxFeeXRP: 2},
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Payment",
+				"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":          "1000000",
+			},
+			responses: map[string][]mockResponse{
+				"account_info": {{result: map[string]any{"account_data": map[string]any{"Sequence": 42}}}},
+				"server_info":  {serverInfoResponse(0.00001, 1)},
+				"ledger":       {ledgerResponse(100)},
+			},
+			expected: transaction.FlatTransaction{
+				"TransactionType":    "Payment",
+				"Account":            "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination":        "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":             "1000000",
+				"Sequence":           uint32(42),
+				"Fee":                "10",
+				"LastLedgerSequence": uint32(100) + commonconstants.LedgerOffset,
+			},
+		},
+		{
+			name: "pass - keeps provided fields and sets network id",
+			cfg:  Config{FeeCushion: 1, MaxFeeXRP: 2, NetworkID: func() uint32 { return 21338 }},
+			tx: transaction.FlatTransaction{
+				"TransactionType":    "Payment",
+				"Account":            "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination":        "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":             "1000000",
+				"Sequence":           uint32(7),
+				"Fee":                "12",
+				"LastLedgerSequence": uint32(200),
+			},
+			responses: map[string][]mockResponse{},
+			expected: transaction.FlatTransaction{
+				"TransactionType":    "Payment",
+				"Account":            "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination":        "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":             "1000000",
+				"Sequence":           uint32(7),
+				"Fee":                "12",
+				"LastLedgerSequence": uint32(200),
+				"NetworkID":          uint32(21338),
+			},
+		},
+		{
+			name: "fail - amount and deliver max mismatch",
+			cfg:  Config{FeeCushion: 1, MaxFeeXRP: 2},
+			tx: transaction.FlatTransaction{
+				"TransactionType":    "Payment",
+				"Account":            "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination":        "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":             "1000000",
+				"DeliverMax":         "2000000",
+				"Sequence":           uint32(7),
+				"Fee":                "12",
+				"LastLedgerSequence": uint32(200),
+			},
+			responses:   map[string][]mockResponse{},
+			expectedErr: ErrAmountAndDeliverMaxMustBeIdentical,
+		},
+		{
+			name: "fail - server error",
+			cfg:  Config{FeeCushion: 1, MaxFeeXRP: 2},
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Payment",
+				"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":          "1000000",
+			},
+			responses: map[string][]mockResponse{
+				"account_info": {{err: errors.New("actNotFound")}},
+			},
+			expectedErr: errors.New("actNotFound"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := New(&mockExecutor{responses: tt.responses}, tt.cfg)
+
+			err := e.Autofill(context.Background(), &tt.tx)
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, tt.tx)
+		})
+	}
+}
+
+func TestEngine_CalculateFee(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         Config
+		tx          transaction.FlatTransaction
+		nSigners    uint64
+		responses   map[string][]mockResponse
+		expectedFee string
+		expectedErr error
+	}{
+		{
+			name:        "pass - applies the fee cushion and load factor",
+			cfg:         Config{FeeCushion: 1.2, MaxFeeXRP: 2},
+			tx:          transaction.FlatTransaction{"TransactionType": "Payment"},
+			responses:   map[string][]mockResponse{"server_info": {serverInfoResponse(0.00001, 2)}},
+			expectedFee: "24",
+		},
+		{
+			name:        "pass - caps the fee to the max fee",
+			cfg:         Config{FeeCushion: 1, MaxFeeXRP: 0.00001},
+			tx:          transaction.FlatTransaction{"TransactionType": "Payment"},
+			responses:   map[string][]mockResponse{"server_info": {serverInfoResponse(0.00001, 1000)}},
+			expectedFee: "10",
+		},
+		{
+			name:        "pass - adds a base fee per signer",
+			cfg:         Config{FeeCushion: 1, MaxFeeXRP: 2},
+			tx:          transaction.FlatTransaction{"TransactionType": "Payment"},
+			nSigners:    3,
+			responses:   map[string][]mockResponse{"server_info": {serverInfoResponse(0.00001, 1)}},
+			expectedFee: "40",
+		},
+		{
+			name: "pass - account delete costs the owner reserve",
+			cfg:  Config{FeeCushion: 1, MaxFeeXRP: 0.1},
+			tx:   transaction.FlatTransaction{"TransactionType": "AccountDelete"},
+			responses: map[string][]mockResponse{
+				"server_info": {serverInfoResponse(0.00001, 1)},
+				"server_state": {{result: map[string]any{
+					"state": map[string]any{"validated_ledger": map[string]any{"reserve_inc": 2000000}},
+				}}},
+			},
+			expectedFee: "2000000",
+		},
+		{
+			name:        "fail - missing base fee",
+			cfg:         Config{FeeCushion: 1, MaxFeeXRP: 2},
+			tx:          transaction.FlatTransaction{"TransactionType": "Payment"},
+			responses:   map[string][]mockResponse{"server_info": {serverInfoResponse(0, 1)}},
+			expectedErr: ErrCouldNotGetBaseFeeXrp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := New(&mockExecutor{responses: tt.responses}, tt.cfg)
+
+			err := e.CalculateFee(context.Background(), &tt.tx, tt.nSigners)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedFee, tt.tx["Fee"])
+		})
+	}
+}
+
+func TestEngine_WaitForTransaction(t *testing.T) {
+	found := mockResponse{result: map[string]any{"ledger_index": 101, "validated": true}}
+
+	tests := []struct {
+		name          string
+		responses     map[string][]mockResponse
+		expectedIndex uint32
+		expectedCalls []string
+		expectedErr   error
+	}{
+		{
+			name: "pass - transaction found",
+			responses: map[string][]mockResponse{
+				"ledger": {ledgerResponse(100)},
+				"tx":     {found},
+			},
+			expectedIndex: 101,
+			expectedCalls: []string{"ledger", "tx"},
+		},
+		{
+			name: "pass - keeps waiting while the transaction is not found",
+			responses: map[string][]mockResponse{
+				"ledger": {ledgerResponse(100)},
+				"tx":     {{err: &xrpl.XRPLError{Token: "txnNotFound", Message: "Transaction not found."}}, found},
+			},
+			expectedIndex: 101,
+			expectedCalls: []string{"ledger", "tx", "ledger", "tx"},
+		},
+		{
+			name: "pass - returns as soon as the transaction is validated",
+			responses: map[string][]mockResponse{
+				"ledger": {ledgerResponse(90)},
+				"tx":     {{result: map[string]any{"ledger_index": 91, "validated": true}}},
+			},
+			expectedIndex: 91,
+			expectedCalls: []string{"ledger", "tx"},
+		},
+		{
+			name: "pass - keeps waiting while the transaction is not validated",
+			responses: map[string][]mockResponse{
+				"ledger": {ledgerResponse(90)},
+				"tx": {
+					{result: map[string]any{"ledger_index": 91, "validated": false}},
+					{result: map[string]any{"ledger_index": 91, "validated": true}},
+				},
+			},
+			expectedIndex: 91,
+			expectedCalls: []string{"ledger", "tx", "ledger", "tx"},
+		},
+		{
+			name: "fail - transaction never found",
+			responses: map[string][]mockResponse{
+				"ledger": {ledgerResponse(100)},
+				"tx":     {{err: &xrpl.XRPLError{Token: "txnNotFound", Message: "Transaction not found."}}},
+			},
+			expectedErr: ErrTransactionNotFound,
+		},
+		{
+			name: "fail - last ledger sequence already passed",
+			responses: map[string][]mockResponse{
+				"ledger": {ledgerResponse(101)},
+			},
+			expectedErr: ErrTransactionNotFound,
+		},
+		{
+			name: "fail - server error",
+			responses: map[string][]mockResponse{
+				"ledger": {ledgerResponse(100)},
+				"tx":     {{err: &xrpl.XRPLError{Token: "tooBusy"}}},
+			},
+			expectedErr: xrpl.ErrTooBusy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := &mockExecutor{responses: tt.responses}
+			e := New(exec, Config{MaxRetries: 3, RetryDelay: time.Millisecond})
+
+			res, err := e.WaitForTransaction(context.Background(), "hash", 101)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedIndex, res.LedgerIndex.Uint32())
+			require.Equal(t, tt.expectedCalls, exec.calls)
+		})
+	}
+}
+
+func TestEngine_SignTx(t *testing.T) {
+	signed := transaction.FlatTransaction{
+		"TransactionType": "Payment",
+		"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount":          "1000000",
+		"Fee":             "10",
+		"Sequence":        uint32(1),
+		"SigningPubKey":   "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+		"TxnSignature":    "C3D3A4A0C0F5A0B0E5A4F3C1F0B7C9A2D1E4F6A8B0C2D4E6F8A0B2C4D6E8F0A2C3D3A4A0C0F5A0B0E5A4F3C1F0B7C9A2D1E4F6A8B0C2D4E6F8A0B2C4D6E8F0A2",
+	}
+	signedBlob, err := binarycodec.Encode(signed)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		tx           transaction.FlatTransaction
+		expectedBlob string
+		expectedErr  error
+	}{
+		{
+			name:         "pass - already signed transaction is encoded",
+			tx:           signed,
+			expectedBlob: signedBlob,
+		},
+		{
+			name: "fail - unsigned transaction without wallet",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Payment",
+				"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			},
+			expectedErr: ErrMissingWallet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := &mockExecutor{}
+			e := New(exec, Config{})
+
+			blob, err := e.SignTx(context.Background(), tt.tx, true, nil)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedBlob, blob)
+			require.Empty(t, exec.calls)
+		})
+	}
+}
+
+func TestEngine_Simulate(t *testing.T) {
+	simulated := mockResponse{result: map[string]any{
+		"engine_result": "tesSUCCESS",
+		"ledger_index":  101,
+		"meta":          map[string]any{"TransactionResult": "tesSUCCESS"},
+	}}
+
+	tests := []struct {
+		name          string
+		tx            transaction.FlatTransaction
+		autofill      bool
+		responses     map[string][]mockResponse
+		expectedCalls []string
+		expectedErr   error
+	}{
+		{
+			name: "pass - missing fields are left to the server",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Payment",
+				"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":          "1000000",
+			},
+			responses:     map[string][]mockResponse{"simulate": {simulated}},
+			expectedCalls: []string{"simulate"},
+		},
+		{
+			name: "pass - autofilled transaction",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Payment",
+				"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Amount":          "1000000",
+			},
+			autofill: true,
+			responses: map[string][]mockResponse{
+				"account_info": {{result: map[string]any{"account_data": map[string]any{"Sequence": 42}}}},
+				"server_info":  {serverInfoResponse(0.00001, 1)},
+				"ledger":       {ledgerResponse(100)},
+				"simulate":     {simulated},
+			},
+			expectedCalls: []string{"account_info", "server_info", "ledger", "simulate"},
+		},
+		{
+			name: "fail - signed transaction",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Payment",
+				"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"SigningPubKey":   "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+				"TxnSignature":    "C3D3A4A0C0F5A0B0E5A4F3C1F0B7C9A2D1E4F6A8B0C2D4E6F8A0B2C4D6E8F0A2",
+			},
+			expectedErr: requests.ErrSimulateSignedTx,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := &mockExecutor{responses: tt.responses}
+			e := New(exec, Config{FeeCushion: 1, MaxFeeXRP: 2})
+
+			res, err := e.Simulate(context.Background(), tt.tx, tt.autofill)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Empty(t, exec.calls)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "tesSUCCESS", res.EngineResult)
+			require.Equal(t, "tesSUCCESS", res.Meta.TransactionResult)
+			require.Equal(t, tt.expectedCalls, exec.calls)
+		})
+	}
+}
+
+func TestEngine_Logger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	e := New(&mockExecutor{responses: map[string][]mockResponse{
+		"account_info": {{result: map[string]any{"account_data": map[string]any{"Sequence": 42}}}},
+		"server_info":  {serverInfoResponse(0.00001, 1)},
+		"ledger":       {ledgerResponse(100)},
+	}}, Config{FeeCushion: 1, MaxFeeXRP: 2, NetworkID: func() uint32 { return 21338 }, Logger: logger})
+
+	tx := transaction.FlatTransaction{
+		"TransactionType": "Payment",
+		"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount":          "1000000",
+	}
+	require.NoError(t, e.Autofill(context.Background(), &tx))
+
+	var records []map[string]any
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var record map[string]any
+		require.NoError(t, dec.Decode(&record))
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 4)
+	require.Equal(t, "autofilled NetworkID", records[0]["msg"])
+	require.Equal(t, "autofilled Sequence", records[1]["msg"])
+	require.Equal(t, float64(42), records[1]["sequence"])
+	require.Equal(t, "calculated fee", records[2]["msg"])
+	require.Equal(t, "Payment", records[2]["transaction_type"])
+	require.Equal(t, float64(10), records[2]["fee"])
+	require.Equal(t, "autofilled LastLedgerSequence", records[3]["msg"])
+	for _, record := range records {
+		require.Equal(t, "DEBUG", record["level"])
+	}
+}
+
+func TestIsNotLaterRippledVersion(t *testing.T) {
+	tests := []struct {
+		source   string
+		target   string
+		expected bool
+	}{
+		{source: "1.11.0", target: "1.11.0", expected: true},
+		{source: "1.10.0", target: "1.11.0", expected: true},
+		{source: "1.11.0", target: "1.10.0", expected: false},
+		{source: "1.11.0", target: "2.0.0", expected: true},
+		{source: "1.11.0", target: "1.11.1", expected: true},
+		{source: "1.10.0", target: "1.10.0-b1", expected: false},
+		{source: "1.10.0-b1", target: "1.10.0", expected: true},
+		{source: "1.10.0-b1", target: "1.10.0-b2", expected: true},
+		{source: "1.10.0-rc2", target: "1.10.0-rc1", expected: false},
+		{source: "1.10", target: "1.11.0", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source+" <= "+tt.target, func(t *testing.T) {
+			require.Equal(t, tt.expected, isNotLaterRippledVersion(tt.source, tt.target))
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// CalculateFee sets the Fee of the transaction according to its type.
+//
+// Enhanced implementation that replicates xrpl.js calculateFeePerTransactionType logic,
+// including special cases for EscrowFinish, AccountDelete, AMMCreate, Batch, LoanSet and multi-signing.
+func (e *Engine) CalculateFee(ctx context.Context, tx *transaction.FlatTransaction, nSigners uint64) error {
+	// Get base network fee
+	netFeeXRP, err := e.getFeeXrp(ctx, e.cfg.FeeCushion)
+	if err != nil {
+		return err
+	}
+
+	netFeeDrops, err := currency.XrpToDrops(netFeeXRP)
+	if err != nil {
+		return err
+	}
+
+	// Convert to uint64 for calculations
+	baseFeeUint, err := strconv.ParseUint(netFeeDrops, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	baseFee := baseFeeUint
+
+	// Get transaction type
+	transactionType := ""
+	if txType, ok := (*tx)["TransactionType"]; ok {
+		if str, ok := txType.(string); ok {
+			transactionType = str
+		}
+	}
+
+	// Check if this is a special transaction cost type
+	isSpecialTxCost := transactionType == "AccountDelete" || transactionType == "AMMCreate"
+
+	switch transactionType {
+	case "EscrowFinish":
+		if fulfillment, ok := (*tx)["Fulfillment"]; ok && fulfillment != nil {
+			if fulfillmentStr, ok := fulfillment.(string); ok && fulfillmentStr != "" {
+				fulfillmentBytesSize := (len(fulfillmentStr) + 1) / 2 // Math.ceil(length / 2)
+				if fulfillmentBytesSize < 0 {
+					return ErrInvalidFulfillmentLength
+				}
+				// BaseFee × (33 + ceil(Fulfillment size in bytes / 16))
+				chunks := (uint64(fulfillmentBytesSize) + 15) / 16 // ceil division
+				baseFee = baseFeeUint * (33 + chunks)
+			}
+		}
+	case "AccountDelete", "AMMCreate":
+		reserveFee, err := e.fetchOwnerReserveFee(ctx)
+		if err != nil {
+			return err
+		}
+		baseFee = reserveFee
+	case "Batch":
+		rawTxFees, err := e.calculateBatchFees(ctx, tx)
+		if err != nil {
+			return err
+		}
+		baseFee = baseFeeUint*2 + rawTxFees
+	case "LoanSet":
+		// For LoanSet, account for counterparty signers
+		counterPartySignersCount, err := e.fetchCounterPartySignersCount(ctx, *tx)
+		if err != nil {
+			return err
+		}
+		baseFee = baseFeeUint + (baseFeeUint * counterPartySignersCount)
+	}
+
+	// Multi-signed Transaction: BaseFee × (1 + Number of Signatures Provided)
+	if nSigners > 0 {
+		signersFee := baseFeeUint * nSigners
+		baseFee += signersFee
+	}
+
+	// Apply max fee limit (but not for special transaction cost types)
+	var totalFee uint64
+	if isSpecialTxCost {
+		totalFee = baseFee
+	} else {
+		maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", e.cfg.MaxFeeXRP))
+		if err != nil {
+			return err
+		}
+		maxFeeUint, err := strconv.ParseUint(maxFeeDrops, 10, 64)
+		if err != nil {
+			return err
+		}
+		if baseFee < maxFeeUint {
+			totalFee = baseFee
+		} else {
+			totalFee = maxFeeUint
+		}
+	}
+
+	(*tx)["Fee"] = strconv.FormatUint(totalFee, 10)
+	e.cfg.Logger.DebugContext(ctx, "calculated fee",
+		"transaction_type", transactionType,
+		"base_fee", baseFeeUint,
+		"signers", nSigners,
+		"fee", totalFee,
+		"capped", totalFee < baseFee,
+	)
+	return nil
+}
+
+// getFeeXrp calculates the current transaction fee for the ledger, in XRP.
+func (e *Engine) getFeeXrp(ctx context.Context, cushion float32) (string, error) {
+	res, err := e.getServerInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if res.Info.ValidatedLedger.BaseFeeXRP == 0 {
+		return "", ErrCouldNotGetBaseFeeXrp
+	}
+
+	loadFactor := res.Info.LoadFactor
+	if res.Info.LoadFactor == 0 {
+		loadFactor = 1
+	}
+
+	fee := res.Info.ValidatedLedger.BaseFeeXRP * float32(loadFactor) * cushion
+
+	if fee > e.cfg.MaxFeeXRP {
+		fee = e.cfg.MaxFeeXRP
+	}
+
+	// Round fee to NUM_DECIMAL_PLACES
+	roundedFee := float32(math.Round(float64(fee)*math.Pow10(int(currency.MaxFractionLength)))) / float32(math.Pow10(int(currency.MaxFractionLength)))
+
+	// Convert the rounded fee back to a string with NUM_DECIMAL_PLACES
+	return fmt.Sprintf("%.*f", currency.MaxFractionLength, roundedFee), nil
+}
+
+// fetchOwnerReserveFee fetches the owner reserve fee from the server state.
+// Replicates the JavaScript fetchOwnerReserveFee function.
+func (e *Engine) fetchOwnerReserveFee(ctx context.Context) (uint64, error) {
+	response, err := e.getServerState(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	reserveInc := response.State.ValidatedLedger.ReserveInc
+	if reserveInc == 0 {
+		return 0, ErrCouldNotFetchOwnerReserve
+	}
+
+	return uint64(reserveInc), nil
+}
+
+// fetchCounterPartySignersCount fetches the number of signers for the counterparty account.
+// For LoanSet transactions, if Counterparty is not provided, it fetches the LoanBroker and uses its Owner.
+// Returns the number of signers in the counterparty's signer list, or 1 if no signer list exists.
+func (e *Engine) fetchCounterPartySignersCount(ctx context.Context, tx transaction.FlatTransaction) (uint64, error) {
+	var counterparty types.Address
+
+	// Extract Counterparty from transaction if present
+	if cp, ok := tx["Counterparty"]; ok {
+		if cpStr, ok := cp.(string); ok && cpStr != "" {
+			counterparty = types.Address(cpStr)
+		}
+	}
+
+	// If Counterparty is not provided and transaction has LoanBrokerID, fetch LoanBroker
+	if counterparty == "" {
+		loanBrokerID, ok := tx["LoanBrokerID"].(string)
+		if !ok || loanBrokerID == "" {
+			return 0, ErrLoanBrokerIDRequired
+		}
+
+		res, err := e.getLedgerEntry(ctx, &ledger.EntryRequest{
+			Index:       loanBrokerID,
+			LedgerIndex: common.LedgerTitle("validated"),
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		// Extract Owner from the LoanBroker FlatLedgerObject
+		owner, ok := res.Node["Owner"].(string)
+		if !ok || owner == "" {
+			return 0, ErrCouldNotFetchLoanBrokerOwner
+		}
+		counterparty = types.Address(owner)
+	}
+
+	if counterparty == "" {
+		return 0, ErrCounterpartyRequired
+	}
+
+	// Fetch account info with signer lists
+	accountInfo, err := e.getAccountInfo(ctx, &account.InfoRequest{
+		Account:     counterparty,
+		LedgerIndex: common.LedgerTitle("validated"),
+		SignerLists: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// Extract the first signer list's SignerEntries length
+	if len(accountInfo.SignerLists) > 0 {
+		return uint64(len(accountInfo.SignerLists[0].SignerEntries)), nil
+	}
+
+	// Default to 1 if no signer list exists
+	return 1, nil
+}
+
+// calculateBatchFees calculates the total fees for all inner transactions in a Batch.
+// Replicates the JavaScript logic for Batch transaction fee calculation.
+func (e *Engine) calculateBatchFees(ctx context.Context, tx *transaction.FlatTransaction) (uint64, error) {
+	var totalFees uint64
+
+	// Get RawTransactions from the batch transaction
+	rawTransactions, ok := (*tx)["RawTransactions"].([]map[string]any)
+	if !ok {
+		return 0, ErrRawTransactionsFieldMissing
+	}
+
+	// Iterate through each raw transaction
+	for _, rawTx := range rawTransactions {
+		// Extract the actual transaction from the wrapper
+		innerTx, ok := rawTx["RawTransaction"].(map[string]any)
+		if !ok {
+			return 0, ErrRawTransactionFieldMissing
+		}
+
+		// Calculate fee for this inner transaction (no multi-signing for inner transactions)
+		innerTxFlat := transaction.FlatTransaction(innerTx)
+		err := e.CalculateFee(ctx, &innerTxFlat, 0)
+		if err != nil {
+			return 0, err
+		}
+
+		// Extract the calculated fee
+		feeStr, ok := innerTx["Fee"].(string)
+		if !ok {
+			return 0, ErrFeeFieldMissing
+		}
+
+		innerTx["Fee"] = "0"
+
+		// Convert fee string to uint64 and add to total
+		feeUint, err := strconv.ParseUint(feeStr, 10, 64)
+		if err != nil {
+			return 0, ErrFailedToParseFee{
+				Fee: feeStr,
+				Err: err,
+			}
+		}
+
+		totalFees += feeUint
+	}
+
+	return totalFees, nil
+}
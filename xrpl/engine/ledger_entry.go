@@ -0,0 +1,40 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+)
+
+// LedgerEntry returns the ledger entry selected by req, decoded as T. The entry is always requested in
+// JSON, even if req asks for the binary form. It fails with ErrUnexpectedLedgerEntryType if the entry
+// isn't of entryType, which can happen when it's selected by index. The request isn't modified.
+//
+// T is the ledger-entry-types struct of entryType, e.g. ledgerentry.RippleState for ledgerentry.RippleStateEntry.
+func LedgerEntry[T any](ctx context.Context, e *Engine, req *ledger.EntryRequest, entryType ledgerentry.EntryType) (*T, error) {
+	r := *req
+	r.Binary = false
+	res, err := e.getLedgerEntry(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := res.Node["LedgerEntryType"].(string)
+	if ledgerentry.EntryType(actual) != entryType {
+		return nil, ErrUnexpectedLedgerEntryType{Expected: entryType, Actual: ledgerentry.EntryType(actual)}
+	}
+
+	// The node is decoded generically by the executors, so it's encoded again to decode it with the
+	// JSON decoding of T.
+	raw, err := json.Marshal(res.Node)
+	if err != nil {
+		return nil, err
+	}
+	var entry T
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
@@ -0,0 +1,63 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerEntry(t *testing.T) {
+	tests := []struct {
+		name        string
+		node        map[string]any
+		expectedErr error
+	}{
+		{
+			name: "pass - decodes the entry of the requested type",
+			node: map[string]any{
+				"LedgerEntryType": "RippleState",
+				"Balance":         map[string]any{"currency": "USD", "issuer": "rrrrrrrrrrrrrrrrrrrrBZbvji", "value": "10"},
+				"HighLimit":       map[string]any{"currency": "USD", "issuer": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "value": "0"},
+				"LowLimit":        map[string]any{"currency": "USD", "issuer": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "value": "100"},
+				"index":           "9CA88CDEDFF9252B3DE183CE35B038F57282BC9503CDFA1923EF9A95DF0D6F7B",
+			},
+		},
+		{
+			name:        "fail - entry of another type",
+			node:        map[string]any{"LedgerEntryType": "Offer"},
+			expectedErr: ErrUnexpectedLedgerEntryType{Expected: ledgerentry.RippleStateEntry, Actual: ledgerentry.OfferEntry},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := &pageExecutor{pages: []map[string]any{{"index": "9CA8", "node": tt.node}}}
+			e := New(exec, Config{})
+			req := &ledger.EntryRequest{
+				RippleState: &ledgertypes.RippleStateSelector{
+					Accounts: [2]types.Address{"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+					Currency: "USD",
+				},
+				Binary: true,
+			}
+
+			entry, err := LedgerEntry[ledgerentry.RippleState](context.Background(), e, req, ledgerentry.RippleStateEntry)
+			if tt.expectedErr != nil {
+				require.Equal(t, tt.expectedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "10", entry.Balance.Value)
+			require.Equal(t, types.Address("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"), entry.LowLimit.Issuer)
+
+			// The entry is requested in JSON without modifying the request.
+			require.NotContains(t, exec.requests[0], "binary")
+			require.True(t, req.Binary)
+		})
+	}
+}
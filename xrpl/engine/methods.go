@@ -0,0 +1,420 @@
+package engine
+
+import (
+	"context"
+	"iter"
+
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/clio"
+	cliotypes "github.com/Peersyst/xrpl-go/xrpl/queries/clio/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+)
+
+// Methods implements the client methods that don't depend on the transport on top of an Engine:
+// iterating over paged queries, reading typed ledger entries and querying Clio servers.
+// The rpc and websocket clients embed it, so that these methods are written once for both.
+//
+// The methods ending in All return an iterator over the items of every page of a paged query, following
+// the markers of the responses. Every page is read from the ledger, or ledger range, of the first one.
+// The request isn't modified, so the iterator can be ranged over several times. The Limit of the request
+// is the size of each page, not a cap on the number of items: break out of the loop to stop early.
+// The iteration stops at the first error, which is yielded with the zero value. A server returning the
+// same marker twice in a row is reported with ErrRepeatedMarker.
+//
+//	for tx, err := range client.AccountTransactionsAll(req) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+type Methods struct {
+	e *Engine
+}
+
+// NewMethods creates the Methods sending their requests through e.
+func NewMethods(e *Engine) Methods {
+	return Methods{e: e}
+}
+
+// clioRequest sends a Clio-only request after checking that the server is a Clio server.
+func clioRequest[T any](ctx context.Context, e *Engine, req Request) (*T, error) {
+	if err := e.RequireClio(ctx, req.Method()); err != nil {
+		return nil, err
+	}
+	return execute[T](ctx, e.exec, req)
+}
+
+// Pagination
+
+// AccountTransactionsAll returns an iterator over the transactions of every page of an account_tx query.
+func (m Methods) AccountTransactionsAll(req *account.TransactionsRequest) iter.Seq2[account.Transaction, error] {
+	return m.AccountTransactionsAllContext(context.Background(), req)
+}
+
+// AccountTransactionsAllContext is like AccountTransactionsAll but uses ctx to cancel the requests and propagate deadlines.
+func (m Methods) AccountTransactionsAllContext(ctx context.Context, req *account.TransactionsRequest) iter.Seq2[account.Transaction, error] {
+	return m.e.AccountTransactionsAll(ctx, req)
+}
+
+// LedgerDataAll returns an iterator over the ledger objects of every page of a ledger_data query.
+func (m Methods) LedgerDataAll(req *ledger.DataRequest) iter.Seq2[ledgertypes.State, error] {
+	return m.LedgerDataAllContext(context.Background(), req)
+}
+
+// LedgerDataAllContext is like LedgerDataAll but uses ctx to cancel the requests and propagate deadlines.
+func (m Methods) LedgerDataAllContext(ctx context.Context, req *ledger.DataRequest) iter.Seq2[ledgertypes.State, error] {
+	return m.e.LedgerDataAll(ctx, req)
+}
+
+// AccountLinesAll returns an iterator over the trust lines of every page of an account_lines query.
+func (m Methods) AccountLinesAll(req *account.LinesRequest) iter.Seq2[accounttypes.TrustLine, error] {
+	return m.AccountLinesAllContext(context.Background(), req)
+}
+
+// AccountLinesAllContext is like AccountLinesAll but uses ctx to cancel the requests and propagate deadlines.
+func (m Methods) AccountLinesAllContext(ctx context.Context, req *account.LinesRequest) iter.Seq2[accounttypes.TrustLine, error] {
+	return m.e.AccountLinesAll(ctx, req)
+}
+
+// AccountObjectsAll returns an iterator over the ledger objects of every page of an account_objects query.
+func (m Methods) AccountObjectsAll(req *account.ObjectsRequest) iter.Seq2[ledgerentry.FlatLedgerObject, error] {
+	return m.AccountObjectsAllContext(context.Background(), req)
+}
+
+// AccountObjectsAllContext is like AccountObjectsAll but uses ctx to cancel the requests and propagate deadlines.
+func (m Methods) AccountObjectsAllContext(ctx context.Context, req *account.ObjectsRequest) iter.Seq2[ledgerentry.FlatLedgerObject, error] {
+	return m.e.AccountObjectsAll(ctx, req)
+}
+
+// AccountChannelsAll returns an iterator over the payment channels of every page of an account_channels query.
+func (m Methods) AccountChannelsAll(req *account.ChannelsRequest) iter.Seq2[accounttypes.ChannelResult, error] {
+	return m.AccountChannelsAllContext(context.Background(), req)
+}
+
+// AccountChannelsAllContext is like AccountChannelsAll but uses ctx to cancel the requests and propagate deadlines.
+func (m Methods) AccountChannelsAllContext(ctx context.Context, req *account.ChannelsRequest) iter.Seq2[accounttypes.ChannelResult, error] {
+	return m.e.AccountChannelsAll(ctx, req)
+}
+
+// AccountOffersAll returns an iterator over the offers of every page of an account_offers query.
+func (m Methods) AccountOffersAll(req *account.OffersRequest) iter.Seq2[accounttypes.OfferResult, error] {
+	return m.AccountOffersAllContext(context.Background(), req)
+}
+
+// AccountOffersAllContext is like AccountOffersAll but uses ctx to cancel the requests and propagate deadlines.
+func (m Methods) AccountOffersAllContext(ctx context.Context, req *account.OffersRequest) iter.Seq2[accounttypes.OfferResult, error] {
+	return m.e.AccountOffersAll(ctx, req)
+}
+
+// AccountNFTsAll returns an iterator over the NFTs of every page of an account_nfts query.
+func (m Methods) AccountNFTsAll(req *account.NFTsRequest) iter.Seq2[accounttypes.NFT, error] {
+	return m.AccountNFTsAllContext(context.Background(), req)
+}
+
+// AccountNFTsAllContext is like AccountNFTsAll but uses ctx to cancel the requests and propagate deadlines.
+func (m Methods) AccountNFTsAllContext(ctx context.Context, req *account.NFTsRequest) iter.Seq2[accounttypes.NFT, error] {
+	return m.e.AccountNFTsAll(ctx, req)
+}
+
+// NFTHistoryAll returns an iterator over the transactions of every page of a Clio nft_history query.
+func (m Methods) NFTHistoryAll(req *clio.NFTHistoryRequest) iter.Seq2[clio.NFTHistoryTransactions, error] {
+	return m.NFTHistoryAllContext(context.Background(), req)
+}
+
+// NFTHistoryAllContext is like NFTHistoryAll but uses ctx to cancel the requests and propagate deadlines.
+func (m Methods) NFTHistoryAllContext(ctx context.Context, req *clio.NFTHistoryRequest) iter.Seq2[clio.NFTHistoryTransactions, error] {
+	return m.e.NFTHistoryAll(ctx, req)
+}
+
+// NFTsByIssuerAll returns an iterator over the NFTs of every page of a Clio nfts_by_issuer query.
+func (m Methods) NFTsByIssuerAll(req *clio.NFTsByIssuerRequest) iter.Seq2[cliotypes.NFToken, error] {
+	return m.NFTsByIssuerAllContext(context.Background(), req)
+}
+
+// NFTsByIssuerAllContext is like NFTsByIssuerAll but uses ctx to cancel the requests and propagate deadlines.
+func (m Methods) NFTsByIssuerAllContext(ctx context.Context, req *clio.NFTsByIssuerRequest) iter.Seq2[cliotypes.NFToken, error] {
+	return m.e.NFTsByIssuerAll(ctx, req)
+}
+
+// Ledger entries
+
+// GetAccountRoot retrieves the AccountRoot of an account with a ledger_entry request.
+// The entry is selected by the AccountRoot field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the AccountRoot type.
+func (m Methods) GetAccountRoot(req *ledger.EntryRequest) (*ledgerentry.AccountRoot, error) {
+	return m.GetAccountRootContext(context.Background(), req)
+}
+
+// GetAccountRootContext is like GetAccountRoot but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetAccountRootContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.AccountRoot, error) {
+	return LedgerEntry[ledgerentry.AccountRoot](ctx, m.e, req, ledgerentry.AccountRootEntry)
+}
+
+// GetRippleState retrieves the trust line between two accounts with a ledger_entry request.
+// The entry is selected by the RippleState field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the RippleState type.
+func (m Methods) GetRippleState(req *ledger.EntryRequest) (*ledgerentry.RippleState, error) {
+	return m.GetRippleStateContext(context.Background(), req)
+}
+
+// GetRippleStateContext is like GetRippleState but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetRippleStateContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.RippleState, error) {
+	return LedgerEntry[ledgerentry.RippleState](ctx, m.e, req, ledgerentry.RippleStateEntry)
+}
+
+// GetOffer retrieves an offer with a ledger_entry request.
+// The entry is selected by the Offer field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the Offer type.
+func (m Methods) GetOffer(req *ledger.EntryRequest) (*ledgerentry.Offer, error) {
+	return m.GetOfferContext(context.Background(), req)
+}
+
+// GetOfferContext is like GetOffer but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetOfferContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Offer, error) {
+	return LedgerEntry[ledgerentry.Offer](ctx, m.e, req, ledgerentry.OfferEntry)
+}
+
+// GetEscrow retrieves an escrow with a ledger_entry request.
+// The entry is selected by the Escrow field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the Escrow type.
+func (m Methods) GetEscrow(req *ledger.EntryRequest) (*ledgerentry.Escrow, error) {
+	return m.GetEscrowContext(context.Background(), req)
+}
+
+// GetEscrowContext is like GetEscrow but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetEscrowContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Escrow, error) {
+	return LedgerEntry[ledgerentry.Escrow](ctx, m.e, req, ledgerentry.EscrowEntry)
+}
+
+// GetPaymentChannel retrieves a payment channel with a ledger_entry request.
+// The entry is selected by the PaymentChannel field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the PayChannel type.
+func (m Methods) GetPaymentChannel(req *ledger.EntryRequest) (*ledgerentry.PayChannel, error) {
+	return m.GetPaymentChannelContext(context.Background(), req)
+}
+
+// GetPaymentChannelContext is like GetPaymentChannel but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetPaymentChannelContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.PayChannel, error) {
+	return LedgerEntry[ledgerentry.PayChannel](ctx, m.e, req, ledgerentry.PayChannelEntry)
+}
+
+// GetCheck retrieves a check with a ledger_entry request.
+// The entry is selected by the Check field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the Check type.
+func (m Methods) GetCheck(req *ledger.EntryRequest) (*ledgerentry.Check, error) {
+	return m.GetCheckContext(context.Background(), req)
+}
+
+// GetCheckContext is like GetCheck but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetCheckContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Check, error) {
+	return LedgerEntry[ledgerentry.Check](ctx, m.e, req, ledgerentry.CheckEntry)
+}
+
+// GetTicket retrieves a ticket with a ledger_entry request.
+// The entry is selected by the Ticket field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the Ticket type.
+func (m Methods) GetTicket(req *ledger.EntryRequest) (*ledgerentry.Ticket, error) {
+	return m.GetTicketContext(context.Background(), req)
+}
+
+// GetTicketContext is like GetTicket but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetTicketContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Ticket, error) {
+	return LedgerEntry[ledgerentry.Ticket](ctx, m.e, req, ledgerentry.TicketEntry)
+}
+
+// GetNFTPage retrieves an NFT page with a ledger_entry request.
+// The entry is selected by the NFTPage field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the NFTokenPage type.
+func (m Methods) GetNFTPage(req *ledger.EntryRequest) (*ledgerentry.NFTokenPage, error) {
+	return m.GetNFTPageContext(context.Background(), req)
+}
+
+// GetNFTPageContext is like GetNFTPage but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetNFTPageContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.NFTokenPage, error) {
+	return LedgerEntry[ledgerentry.NFTokenPage](ctx, m.e, req, ledgerentry.NFTokenPageEntry)
+}
+
+// GetAMM retrieves the AMM of an asset pair with a ledger_entry request.
+// The entry is selected by the AMM field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the AMM type.
+func (m Methods) GetAMM(req *ledger.EntryRequest) (*ledgerentry.AMM, error) {
+	return m.GetAMMContext(context.Background(), req)
+}
+
+// GetAMMContext is like GetAMM but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetAMMContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.AMM, error) {
+	return LedgerEntry[ledgerentry.AMM](ctx, m.e, req, ledgerentry.AMMEntry)
+}
+
+// GetDID retrieves the DID of an account with a ledger_entry request.
+// The entry is selected by the DID field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the DID type.
+func (m Methods) GetDID(req *ledger.EntryRequest) (*ledgerentry.DID, error) {
+	return m.GetDIDContext(context.Background(), req)
+}
+
+// GetDIDContext is like GetDID but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetDIDContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.DID, error) {
+	return LedgerEntry[ledgerentry.DID](ctx, m.e, req, ledgerentry.DIDEntry)
+}
+
+// GetOracle retrieves a price oracle with a ledger_entry request.
+// The entry is selected by the Oracle field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the Oracle type.
+func (m Methods) GetOracle(req *ledger.EntryRequest) (*ledgerentry.Oracle, error) {
+	return m.GetOracleContext(context.Background(), req)
+}
+
+// GetOracleContext is like GetOracle but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetOracleContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Oracle, error) {
+	return LedgerEntry[ledgerentry.Oracle](ctx, m.e, req, ledgerentry.OracleEntry)
+}
+
+// GetCredential retrieves a credential with a ledger_entry request.
+// The entry is selected by the Credential field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the Credential type.
+func (m Methods) GetCredential(req *ledger.EntryRequest) (*ledgerentry.Credential, error) {
+	return m.GetCredentialContext(context.Background(), req)
+}
+
+// GetCredentialContext is like GetCredential but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetCredentialContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Credential, error) {
+	return LedgerEntry[ledgerentry.Credential](ctx, m.e, req, ledgerentry.CredentialEntry)
+}
+
+// GetMPTIssuance retrieves an MPT issuance with a ledger_entry request.
+// The entry is selected by the MPTIssuance field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the MPTokenIssuance type.
+func (m Methods) GetMPTIssuance(req *ledger.EntryRequest) (*ledgerentry.MPTokenIssuance, error) {
+	return m.GetMPTIssuanceContext(context.Background(), req)
+}
+
+// GetMPTIssuanceContext is like GetMPTIssuance but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetMPTIssuanceContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.MPTokenIssuance, error) {
+	return LedgerEntry[ledgerentry.MPTokenIssuance](ctx, m.e, req, ledgerentry.MPTokenIssuanceEntry)
+}
+
+// GetMPToken retrieves the MPToken of a holder with a ledger_entry request.
+// The entry is selected by the MPToken field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the MPToken type.
+func (m Methods) GetMPToken(req *ledger.EntryRequest) (*ledgerentry.MPToken, error) {
+	return m.GetMPTokenContext(context.Background(), req)
+}
+
+// GetMPTokenContext is like GetMPToken but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetMPTokenContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.MPToken, error) {
+	return LedgerEntry[ledgerentry.MPToken](ctx, m.e, req, ledgerentry.MPTokenEntry)
+}
+
+// GetPermissionedDomain retrieves a permissioned domain with a ledger_entry request.
+// The entry is selected by the PermissionedDomain field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the PermissionedDomain type.
+func (m Methods) GetPermissionedDomain(req *ledger.EntryRequest) (*ledgerentry.PermissionedDomain, error) {
+	return m.GetPermissionedDomainContext(context.Background(), req)
+}
+
+// GetPermissionedDomainContext is like GetPermissionedDomain but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetPermissionedDomainContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.PermissionedDomain, error) {
+	return LedgerEntry[ledgerentry.PermissionedDomain](ctx, m.e, req, ledgerentry.PermissionedDomainEntry)
+}
+
+// GetBridge retrieves a cross-chain bridge with a ledger_entry request.
+// The entry is selected by the BridgeAccount and Bridge fields of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the Bridge type.
+func (m Methods) GetBridge(req *ledger.EntryRequest) (*ledgerentry.Bridge, error) {
+	return m.GetBridgeContext(context.Background(), req)
+}
+
+// GetBridgeContext is like GetBridge but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetBridgeContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.Bridge, error) {
+	return LedgerEntry[ledgerentry.Bridge](ctx, m.e, req, ledgerentry.BridgeEntry)
+}
+
+// GetXChainOwnedClaimID retrieves a cross-chain claim ID with a ledger_entry request.
+// The entry is selected by the XChainOwnedClaimID field of req, or by its Index. It fails with
+// ErrUnexpectedLedgerEntryType if the entry isn't of the XChainOwnedClaimID type.
+func (m Methods) GetXChainOwnedClaimID(req *ledger.EntryRequest) (*ledgerentry.XChainOwnedClaimID, error) {
+	return m.GetXChainOwnedClaimIDContext(context.Background(), req)
+}
+
+// GetXChainOwnedClaimIDContext is like GetXChainOwnedClaimID but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetXChainOwnedClaimIDContext(ctx context.Context, req *ledger.EntryRequest) (*ledgerentry.XChainOwnedClaimID, error) {
+	return LedgerEntry[ledgerentry.XChainOwnedClaimID](ctx, m.e, req, ledgerentry.XChainOwnedClaimIDEntry)
+}
+
+// Clio
+
+// IsClio reports whether the server is a Clio server, from the clio_version of its server_info.
+// The result is cached by the client. A websocket client detects it again after a reconnect, while an rpc
+// client on a Pool caches the result of the endpoint that answered, so the Clio-only methods need a Pool of
+// Clio servers.
+func (m Methods) IsClio() (bool, error) {
+	return m.IsClioContext(context.Background())
+}
+
+// IsClioContext is like IsClio but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) IsClioContext(ctx context.Context) (bool, error) {
+	return m.e.IsClio(ctx)
+}
+
+// GetNFTInfo retrieves the owner, flags, issuer and URI of an NFToken with the Clio nft_info method.
+// It fails with ErrClioRequired if the server isn't a Clio server.
+func (m Methods) GetNFTInfo(req *clio.NFTInfoRequest) (*clio.NFTInfoResponse, error) {
+	return m.GetNFTInfoContext(context.Background(), req)
+}
+
+// GetNFTInfoContext is like GetNFTInfo but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetNFTInfoContext(ctx context.Context, req *clio.NFTInfoRequest) (*clio.NFTInfoResponse, error) {
+	return clioRequest[clio.NFTInfoResponse](ctx, m.e, req)
+}
+
+// GetNFTHistory retrieves a page of the transactions that involved an NFToken with the Clio nft_history method.
+// Use NFTHistoryAll to iterate over every page.
+// It fails with ErrClioRequired if the server isn't a Clio server.
+func (m Methods) GetNFTHistory(req *clio.NFTHistoryRequest) (*clio.NFTHistoryResponse, error) {
+	return m.GetNFTHistoryContext(context.Background(), req)
+}
+
+// GetNFTHistoryContext is like GetNFTHistory but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetNFTHistoryContext(ctx context.Context, req *clio.NFTHistoryRequest) (*clio.NFTHistoryResponse, error) {
+	return clioRequest[clio.NFTHistoryResponse](ctx, m.e, req)
+}
+
+// GetNFTsByIssuer retrieves a page of the NFTokens issued by an account with the Clio nfts_by_issuer method.
+// Use NFTsByIssuerAll to iterate over every page.
+// It fails with ErrClioRequired if the server isn't a Clio server.
+func (m Methods) GetNFTsByIssuer(req *clio.NFTsByIssuerRequest) (*clio.NFTsByIssuerResponse, error) {
+	return m.GetNFTsByIssuerContext(context.Background(), req)
+}
+
+// GetNFTsByIssuerContext is like GetNFTsByIssuer but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetNFTsByIssuerContext(ctx context.Context, req *clio.NFTsByIssuerRequest) (*clio.NFTsByIssuerResponse, error) {
+	return clioRequest[clio.NFTsByIssuerResponse](ctx, m.e, req)
+}
+
+// GetClioLedgerIndex retrieves the most recent validated ledger closed at or before a date with the Clio
+// ledger_index method, or the latest validated ledger if the request has no date.
+// It fails with ErrClioRequired if the server isn't a Clio server.
+func (m Methods) GetClioLedgerIndex(req *clio.LedgerIndexRequest) (*clio.LedgerIndexResponse, error) {
+	return m.GetClioLedgerIndexContext(context.Background(), req)
+}
+
+// GetClioLedgerIndexContext is like GetClioLedgerIndex but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetClioLedgerIndexContext(ctx context.Context, req *clio.LedgerIndexRequest) (*clio.LedgerIndexResponse, error) {
+	return clioRequest[clio.LedgerIndexResponse](ctx, m.e, req)
+}
+
+// GetMPTHolders retrieves a page of the accounts holding an MPT issuance, and their balances, with the Clio
+// mpt_holders method.
+// It fails with ErrClioRequired if the server isn't a Clio server.
+func (m Methods) GetMPTHolders(req *clio.MPTHoldersRequest) (*clio.MPTHoldersResponse, error) {
+	return m.GetMPTHoldersContext(context.Background(), req)
+}
+
+// GetMPTHoldersContext is like GetMPTHolders but uses ctx to cancel the request and propagate deadlines.
+func (m Methods) GetMPTHoldersContext(ctx context.Context, req *clio.MPTHoldersRequest) (*clio.MPTHoldersResponse, error) {
+	return clioRequest[clio.MPTHoldersResponse](ctx, m.e, req)
+}
@@ -0,0 +1,191 @@
+package engine
+
+import (
+	"context"
+	"iter"
+	"reflect"
+
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/clio"
+	cliotypes "github.com/Peersyst/xrpl-go/xrpl/queries/clio/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+)
+
+// paginate returns an iterator over the items of every page of a paged query, starting with req.
+// Every iteration works on its own copy of req, so req isn't modified and the iterator can be ranged
+// over several times. After each page, next prepares the copy for the following one and returns the
+// marker of the page, or nil if it's the last one.
+//
+// The Limit of req is the size of each page: every page is fetched until the last one or until the caller
+// stops ranging, there is no cap on the total number of items.
+//
+// The iteration stops after the first error, which is yielded with the zero item, including the
+// error of ctx once it's done. A server returning the same marker twice in a row is reported with
+// ErrRepeatedMarker instead of being followed forever.
+func paginate[R any, Req interface {
+	*R
+	Request
+}, Res, Item any](ctx context.Context, exec Executor, req Req, items func(*Res) []Item, next func(Req, *Res) any) iter.Seq2[Item, error] {
+	return func(yield func(Item, error) bool) {
+		var zero Item
+		r := *req
+		var marker any
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			res, err := execute[Res](ctx, exec, Req(&r))
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items(res) {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			prev := marker
+			if marker = next(&r, res); marker == nil {
+				return
+			}
+			if prev != nil && reflect.DeepEqual(marker, prev) {
+				yield(zero, ErrRepeatedMarker)
+				return
+			}
+		}
+	}
+}
+
+// pinLedger returns the ledger to request the pages after the first one from, so that every page is read
+// from the same ledger version as the first one. A ledger given by hash is already pinned, otherwise the
+// first non-zero index of the first page is used.
+func pinLedger(specifier common.LedgerSpecifier, hash common.LedgerHash, indexes ...common.LedgerIndex) common.LedgerSpecifier {
+	if hash != "" {
+		return specifier
+	}
+	for _, index := range indexes {
+		if index != 0 {
+			return index
+		}
+	}
+	return specifier
+}
+
+// AccountTransactionsAll returns an iterator over the transactions of every page of an account_tx query.
+func (e *Engine) AccountTransactionsAll(ctx context.Context, req *account.TransactionsRequest) iter.Seq2[account.Transaction, error] {
+	return paginate(ctx, e.exec, req,
+		func(res *account.TransactionsResponse) []account.Transaction { return res.Transactions },
+		func(r *account.TransactionsRequest, res *account.TransactionsResponse) any {
+			if r.LedgerHash == "" && r.LedgerIndex == nil {
+				r.LedgerIndexMin = res.LedgerIndexMin.Int()
+				r.LedgerIndexMax = res.LedgerIndexMax.Int()
+			}
+			r.Marker = res.Marker
+			return res.Marker
+		},
+	)
+}
+
+// LedgerDataAll returns an iterator over the ledger objects of every page of a ledger_data query.
+func (e *Engine) LedgerDataAll(ctx context.Context, req *ledger.DataRequest) iter.Seq2[ledgertypes.State, error] {
+	return paginate(ctx, e.exec, req,
+		func(res *ledger.DataResponse) []ledgertypes.State { return res.State },
+		func(r *ledger.DataRequest, res *ledger.DataResponse) any {
+			if r.LedgerHash == "" && res.LedgerHash != "" {
+				r.LedgerHash = res.LedgerHash
+				r.LedgerIndex = nil
+			}
+			r.Marker = res.Marker
+			return res.Marker
+		},
+	)
+}
+
+// AccountLinesAll returns an iterator over the trust lines of every page of an account_lines query.
+func (e *Engine) AccountLinesAll(ctx context.Context, req *account.LinesRequest) iter.Seq2[accounttypes.TrustLine, error] {
+	return paginate(ctx, e.exec, req,
+		func(res *account.LinesResponse) []accounttypes.TrustLine { return res.Lines },
+		func(r *account.LinesRequest, res *account.LinesResponse) any {
+			r.LedgerIndex = pinLedger(r.LedgerIndex, r.LedgerHash, res.LedgerIndex, res.LedgerCurrentIndex)
+			r.Marker = res.Marker
+			return res.Marker
+		},
+	)
+}
+
+// AccountObjectsAll returns an iterator over the ledger objects of every page of an account_objects query.
+func (e *Engine) AccountObjectsAll(ctx context.Context, req *account.ObjectsRequest) iter.Seq2[ledgerentry.FlatLedgerObject, error] {
+	return paginate(ctx, e.exec, req,
+		func(res *account.ObjectsResponse) []ledgerentry.FlatLedgerObject { return res.AccountObjects },
+		func(r *account.ObjectsRequest, res *account.ObjectsResponse) any {
+			r.LedgerIndex = pinLedger(r.LedgerIndex, r.LedgerHash, res.LedgerIndex, res.LedgerCurrentIndex)
+			r.Marker = res.Marker
+			return res.Marker
+		},
+	)
+}
+
+// AccountChannelsAll returns an iterator over the payment channels of every page of an account_channels query.
+func (e *Engine) AccountChannelsAll(ctx context.Context, req *account.ChannelsRequest) iter.Seq2[accounttypes.ChannelResult, error] {
+	return paginate(ctx, e.exec, req,
+		func(res *account.ChannelsResponse) []accounttypes.ChannelResult { return res.Channels },
+		func(r *account.ChannelsRequest, res *account.ChannelsResponse) any {
+			r.LedgerIndex = pinLedger(r.LedgerIndex, r.LedgerHash, res.LedgerIndex)
+			r.Marker = res.Marker
+			return res.Marker
+		},
+	)
+}
+
+// AccountOffersAll returns an iterator over the offers of every page of an account_offers query.
+func (e *Engine) AccountOffersAll(ctx context.Context, req *account.OffersRequest) iter.Seq2[accounttypes.OfferResult, error] {
+	return paginate(ctx, e.exec, req,
+		func(res *account.OffersResponse) []accounttypes.OfferResult { return res.Offers },
+		func(r *account.OffersRequest, res *account.OffersResponse) any {
+			r.LedgerIndex = pinLedger(r.LedgerIndex, r.LedgerHash, res.LedgerIndex, res.LedgerCurrentIndex)
+			r.Marker = res.Marker
+			return res.Marker
+		},
+	)
+}
+
+// AccountNFTsAll returns an iterator over the NFTs of every page of an account_nfts query.
+func (e *Engine) AccountNFTsAll(ctx context.Context, req *account.NFTsRequest) iter.Seq2[accounttypes.NFT, error] {
+	return paginate(ctx, e.exec, req,
+		func(res *account.NFTsResponse) []accounttypes.NFT { return res.AccountNFTs },
+		func(r *account.NFTsRequest, res *account.NFTsResponse) any {
+			r.LedgerIndex = pinLedger(r.LedgerIndex, r.LedgerHash, res.LedgerIndex, res.LedgerCurrentIndex)
+			r.Marker = res.Marker
+			return res.Marker
+		},
+	)
+}
+
+// NFTHistoryAll returns an iterator over the transactions of every page of a Clio nft_history query.
+func (e *Engine) NFTHistoryAll(ctx context.Context, req *clio.NFTHistoryRequest) iter.Seq2[clio.NFTHistoryTransactions, error] {
+	return paginate(ctx, e.exec, req,
+		func(res *clio.NFTHistoryResponse) []clio.NFTHistoryTransactions { return res.Transactions },
+		func(r *clio.NFTHistoryRequest, res *clio.NFTHistoryResponse) any {
+			r.LedgerIndexMin = res.LedgerIndexMin
+			r.LedgerIndexMax = res.LedgerIndexMax
+			r.Marker = res.Marker
+			return res.Marker
+		},
+	)
+}
+
+// NFTsByIssuerAll returns an iterator over the NFTs of every page of a Clio nfts_by_issuer query.
+func (e *Engine) NFTsByIssuerAll(ctx context.Context, req *clio.NFTsByIssuerRequest) iter.Seq2[cliotypes.NFToken, error] {
+	return paginate(ctx, e.exec, req,
+		func(res *clio.NFTsByIssuerResponse) []cliotypes.NFToken { return res.NFTs },
+		func(r *clio.NFTsByIssuerRequest, res *clio.NFTsByIssuerResponse) any {
+			r.Marker = res.Marker
+			return res.Marker
+		},
+	)
+}
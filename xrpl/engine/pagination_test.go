@@ -0,0 +1,243 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/stretchr/testify/require"
+)
+
+// pageExecutor answers every request with the next page and records the requests as sent.
+type pageExecutor struct {
+	pages    []map[string]any
+	err      error
+	requests []map[string]any
+}
+
+func (p *pageExecutor) Execute(_ context.Context, req Request, result any) error {
+	sent, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(sent, &fields); err != nil {
+		return err
+	}
+	p.requests = append(p.requests, fields)
+
+	if len(p.pages) == 0 {
+		return p.err
+	}
+	page := p.pages[0]
+	p.pages = p.pages[1:]
+	raw, err := json.Marshal(page)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, result)
+}
+
+func TestEngine_AccountTransactionsAll(t *testing.T) {
+	tests := []struct {
+		name             string
+		pages            []map[string]any
+		err              error
+		breakAfter       int
+		expectedHashes   []string
+		expectedRequests []map[string]any
+		expectedErr      error
+	}{
+		{
+			name: "pass - follows markers within the ledger range of the first page",
+			pages: []map[string]any{
+				{
+					"ledger_index_min": 100, "ledger_index_max": 200,
+					"transactions": []any{map[string]any{"hash": "A"}, map[string]any{"hash": "B"}},
+					"marker":       map[string]any{"ledger": 150, "seq": 0},
+				},
+				{
+					"ledger_index_min": 100, "ledger_index_max": 200,
+					"transactions": []any{map[string]any{"hash": "C"}},
+				},
+			},
+			expectedHashes: []string{"A", "B", "C"},
+			expectedRequests: []map[string]any{
+				{"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "limit": float64(2)},
+				{
+					"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "limit": float64(2),
+					"ledger_index_min": float64(100), "ledger_index_max": float64(200),
+					"marker": map[string]any{"ledger": float64(150), "seq": float64(0)},
+				},
+			},
+		},
+		{
+			name: "pass - stops when the caller breaks",
+			pages: []map[string]any{
+				{
+					"transactions": []any{map[string]any{"hash": "A"}, map[string]any{"hash": "B"}},
+					"marker":       "next",
+				},
+			},
+			breakAfter:     1,
+			expectedHashes: []string{"A"},
+			expectedRequests: []map[string]any{
+				{"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "limit": float64(2)},
+			},
+		},
+		{
+			name: "fail - server repeats the marker",
+			pages: []map[string]any{
+				{
+					"transactions": []any{map[string]any{"hash": "A"}},
+					"marker":       map[string]any{"ledger": 150, "seq": 1},
+				},
+				{
+					"transactions": []any{map[string]any{"hash": "B"}},
+					"marker":       map[string]any{"ledger": 150, "seq": 1},
+				},
+			},
+			expectedHashes: []string{"A", "B"},
+			expectedErr:    ErrRepeatedMarker,
+		},
+		{
+			name: "fail - error on the second page",
+			pages: []map[string]any{
+				{
+					"transactions": []any{map[string]any{"hash": "A"}},
+					"marker":       "next",
+				},
+			},
+			err:            errors.New("tooBusy"),
+			expectedHashes: []string{"A"},
+			expectedErr:    errors.New("tooBusy"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := &pageExecutor{pages: tt.pages, err: tt.err}
+			e := New(exec, Config{})
+			req := &account.TransactionsRequest{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", Limit: 2}
+
+			var hashes []string
+			var err error
+			for tx, iterErr := range e.AccountTransactionsAll(context.Background(), req) {
+				if iterErr != nil {
+					err = iterErr
+					break
+				}
+				hashes = append(hashes, string(tx.Hash))
+				if len(hashes) == tt.breakAfter {
+					break
+				}
+			}
+
+			require.Equal(t, tt.expectedHashes, hashes)
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedRequests, exec.requests)
+			}
+			// The request of the caller isn't modified.
+			require.Nil(t, req.Marker)
+			require.Zero(t, req.LedgerIndexMax)
+		})
+	}
+}
+
+func TestEngine_AccountTransactionsAll_RangedTwice(t *testing.T) {
+	page := func(hash, marker string) map[string]any {
+		res := map[string]any{"transactions": []any{map[string]any{"hash": hash}}}
+		if marker != "" {
+			res["marker"] = marker
+		}
+		return res
+	}
+	exec := &pageExecutor{pages: []map[string]any{
+		page("A", "m1"), page("B", ""),
+		page("A", "m1"), page("B", ""),
+	}}
+	e := New(exec, Config{})
+	txs := e.AccountTransactionsAll(context.Background(), &account.TransactionsRequest{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"})
+
+	// Every range starts over from the first page.
+	for range 2 {
+		var hashes []string
+		for tx, err := range txs {
+			require.NoError(t, err)
+			hashes = append(hashes, string(tx.Hash))
+		}
+		require.Equal(t, []string{"A", "B"}, hashes)
+	}
+
+	first := map[string]any{"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"}
+	next := map[string]any{"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "marker": "m1"}
+	require.Equal(t, []map[string]any{first, next, first, next}, exec.requests)
+}
+
+func TestEngine_LedgerDataAll(t *testing.T) {
+	exec := &pageExecutor{pages: []map[string]any{
+		{"ledger_index": "300", "ledger_hash": "ABC", "state": []any{map[string]any{"index": "1"}}, "marker": "m1"},
+		{"ledger_index": "300", "ledger_hash": "ABC", "state": []any{map[string]any{"index": "2"}}},
+	}}
+	e := New(exec, Config{})
+
+	var indexes []string
+	for state, err := range e.LedgerDataAll(context.Background(), &ledger.DataRequest{LedgerIndex: common.LedgerTitle("validated")}) {
+		require.NoError(t, err)
+		indexes = append(indexes, state.Index)
+	}
+
+	require.Equal(t, []string{"1", "2"}, indexes)
+	require.Equal(t, []map[string]any{
+		{"ledger_index": "validated"},
+		{"ledger_hash": "ABC", "marker": "m1"},
+	}, exec.requests)
+}
+
+func TestEngine_AccountLinesAll(t *testing.T) {
+	exec := &pageExecutor{pages: []map[string]any{
+		{"ledger_current_index": 301, "lines": []any{map[string]any{"account": "rA"}}, "marker": "m1"},
+		{"ledger_current_index": 302, "lines": []any{map[string]any{"account": "rB"}}},
+	}}
+	e := New(exec, Config{})
+
+	var peers []string
+	for line, err := range e.AccountLinesAll(context.Background(), &account.LinesRequest{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"}) {
+		require.NoError(t, err)
+		peers = append(peers, string(line.Account))
+	}
+
+	require.Equal(t, []string{"rA", "rB"}, peers)
+	require.Equal(t, []map[string]any{
+		{"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"},
+		{"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "ledger_index": float64(301), "marker": "m1"},
+	}, exec.requests)
+}
+
+func TestEngine_paginate_ContextDone(t *testing.T) {
+	exec := &pageExecutor{pages: []map[string]any{
+		{"transactions": []any{map[string]any{"hash": "A"}}, "marker": "next"},
+	}}
+	e := New(exec, Config{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var err error
+	for _, iterErr := range e.AccountTransactionsAll(ctx, &account.TransactionsRequest{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"}) {
+		if iterErr != nil {
+			err = iterErr
+			break
+		}
+		cancel()
+	}
+
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, exec.requests, 1)
+}
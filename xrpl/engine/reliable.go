@@ -0,0 +1,162 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+)
+
+// SubmitTxBlobReliable submits a signed transaction blob and tracks it until its outcome is final.
+//
+// The blob is resubmitted after every retry delay while its last preliminary result is retriable (ter and tel
+// results, such as terQUEUED or telINSUF_FEE_P), until the transaction is validated or the last validated
+// ledger reaches its LastLedgerSequence. A transaction that isn't validated by then is reported as expired
+// only if the server holds every ledger it could have been included in, otherwise ErrIncompleteLedgerHistory
+// is returned. Malformed transactions and transactions failing with a tef result on their first submission
+// are reported as rejected.
+//
+// There is no limit on the number of lookups besides the LastLedgerSequence: use ctx to bound the wait.
+func (e *Engine) SubmitTxBlobReliable(ctx context.Context, txBlob string, failHard bool) (*xrpl.SubmissionResult, error) {
+	tx, err := binarycodec.Decode(txBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	lastLedgerSequence, ok := tx["LastLedgerSequence"].(uint32)
+	if !ok {
+		return nil, ErrMissingLastLedgerSequenceInTransaction
+	}
+
+	txHash, err := hash.SignTxBlob(txBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transaction can't be included in a ledger validated before its first submission.
+	info, err := e.getServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	minLedger := uint32(info.Info.ValidatedLedger.Seq) + 1
+
+	res, err := e.SubmitTxBlob(ctx, txBlob, failHard)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &xrpl.SubmissionResult{
+		Hash:         txHash,
+		EngineResult: res.EngineResult,
+		Submissions:  1,
+	}
+	e.cfg.Logger.DebugContext(ctx, "submitted transaction", "hash", txHash, "engine_result", res.EngineResult)
+
+	if isRejected(res.EngineResult) {
+		result.Outcome = xrpl.OutcomeRejected
+		return result, nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(e.cfg.RetryDelay):
+		}
+
+		// The server info is fetched before looking the transaction up, so a transaction validated in a
+		// ledger up to the last validated one is always found.
+		info, err := e.getServerInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		txResponse, err := execute[requests.TxResponse](ctx, e.exec, &requests.TxRequest{
+			Transaction: txHash,
+		})
+		if err != nil && !errors.Is(err, xrpl.ErrTxnNotFound) {
+			return nil, err
+		}
+		if txResponse != nil && txResponse.Validated {
+			result.Tx = txResponse
+			result.EngineResult = txResponse.Meta.TransactionResult
+			result.Outcome = xrpl.OutcomeTecFailed
+			if result.EngineResult == "tesSUCCESS" {
+				result.Outcome = xrpl.OutcomeSuccess
+			}
+			return result, nil
+		}
+
+		validatedLedger := uint32(info.Info.ValidatedLedger.Seq)
+		if validatedLedger >= lastLedgerSequence {
+			if !ledgerRangeAvailable(info.Info.CompleteLedgers, minLedger, lastLedgerSequence) {
+				return nil, ErrIncompleteLedgerHistory
+			}
+			result.Outcome = xrpl.OutcomeExpired
+			return result, nil
+		}
+
+		if !isRetriable(result.EngineResult) {
+			continue
+		}
+
+		res, err := e.SubmitTxBlob(ctx, txBlob, failHard)
+		if err != nil {
+			// The transaction may still be validated: keep tracking it until its LastLedgerSequence.
+			e.cfg.Logger.WarnContext(ctx, "failed to resubmit transaction", "hash", txHash, "error", err)
+			continue
+		}
+		result.Submissions++
+		result.EngineResult = res.EngineResult
+		e.cfg.Logger.DebugContext(ctx, "resubmitted transaction",
+			"hash", txHash,
+			"engine_result", res.EngineResult,
+			"submissions", result.Submissions,
+			"validated_ledger", validatedLedger,
+			"last_ledger_sequence", lastLedgerSequence,
+		)
+	}
+}
+
+// isRetriable reports whether a transaction with the given preliminary result may succeed if resubmitted.
+func isRetriable(engineResult string) bool {
+	return strings.HasPrefix(engineResult, "ter") || strings.HasPrefix(engineResult, "tel")
+}
+
+// isRejected reports whether a transaction with the given result on its first submission can never be validated.
+// A transaction whose sequence is past or that is already applied may have been submitted before, so it's tracked.
+func isRejected(engineResult string) bool {
+	if engineResult == "tefPAST_SEQ" || engineResult == "tefALREADY" {
+		return false
+	}
+	return strings.HasPrefix(engineResult, "tem") || strings.HasPrefix(engineResult, "tef")
+}
+
+// ledgerRangeAvailable reports whether the complete_ledgers of a server, such as "32570-6595042,6595044",
+// include every ledger from minLedger to maxLedger.
+func ledgerRangeAvailable(completeLedgers string, minLedger, maxLedger uint32) bool {
+	for _, r := range strings.Split(completeLedgers, ",") {
+		first, last, found := strings.Cut(strings.TrimSpace(r), "-")
+		if !found {
+			last = first
+		}
+		start, err := strconv.ParseUint(first, 10, 32)
+		if err != nil {
+			continue
+		}
+		end, err := strconv.ParseUint(last, 10, 32)
+		if err != nil {
+			continue
+		}
+		if uint32(start) <= minLedger && maxLedger <= uint32(end) {
+			return true
+		}
+	}
+	return false
+}
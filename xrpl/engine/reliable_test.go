@@ -0,0 +1,203 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func serverInfoLedgersResponse(validatedLedger uint32, completeLedgers string) mockResponse {
+	return mockResponse{result: map[string]any{
+		"info": map[string]any{
+			"validated_ledger": map[string]any{"seq": validatedLedger},
+			"complete_ledgers": completeLedgers,
+		},
+	}}
+}
+
+func submitResponse(engineResult string) mockResponse {
+	return mockResponse{result: map[string]any{"engine_result": engineResult}}
+}
+
+func validatedTxResponse(transactionResult string) mockResponse {
+	return mockResponse{result: map[string]any{
+		"ledger_index": 105,
+		"validated":    true,
+		"meta":         map[string]any{"TransactionResult": transactionResult},
+	}}
+}
+
+func TestEngine_SubmitTxBlobReliable(t *testing.T) {
+	txnNotFound := mockResponse{err: &xrpl.XRPLError{Token: "txnNotFound"}}
+
+	tx := transaction.FlatTransaction{
+		"TransactionType":    "Payment",
+		"Account":            "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"Destination":        "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount":             "1000000",
+		"Fee":                "10",
+		"Sequence":           uint32(1),
+		"LastLedgerSequence": uint32(120),
+		"SigningPubKey":      "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+		"TxnSignature":       "C3D3A4A0C0F5A0B0E5A4F3C1F0B7C9A2D1E4F6A8B0C2D4E6F8A0B2C4D6E8F0A2C3D3A4A0C0F5A0B0E5A4F3C1F0B7C9A2D1E4F6A8B0C2D4E6F8A0B2C4D6E8F0A2",
+	}
+	blob, err := binarycodec.Encode(tx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                string
+		responses           map[string][]mockResponse
+		expectedOutcome     xrpl.Outcome
+		expectedResult      string
+		expectedSubmissions int
+		expectedErr         error
+	}{
+		{
+			name: "pass - validated with tesSUCCESS",
+			responses: map[string][]mockResponse{
+				"server_info": {serverInfoLedgersResponse(100, "1-100")},
+				"submit":      {submitResponse("tesSUCCESS")},
+				"tx":          {txnNotFound, validatedTxResponse("tesSUCCESS")},
+			},
+			expectedOutcome:     xrpl.OutcomeSuccess,
+			expectedResult:      "tesSUCCESS",
+			expectedSubmissions: 1,
+		},
+		{
+			name: "pass - resubmits while the preliminary result is retriable",
+			responses: map[string][]mockResponse{
+				"server_info": {serverInfoLedgersResponse(100, "1-100")},
+				"submit":      {submitResponse("terQUEUED"), submitResponse("telINSUF_FEE_P"), submitResponse("tesSUCCESS")},
+				"tx":          {txnNotFound, txnNotFound, txnNotFound, validatedTxResponse("tesSUCCESS")},
+			},
+			expectedOutcome:     xrpl.OutcomeSuccess,
+			expectedResult:      "tesSUCCESS",
+			expectedSubmissions: 3,
+		},
+		{
+			name: "pass - validated with a tec result",
+			responses: map[string][]mockResponse{
+				"server_info": {serverInfoLedgersResponse(100, "1-100")},
+				"submit":      {submitResponse("tecUNFUNDED_PAYMENT")},
+				"tx":          {validatedTxResponse("tecUNFUNDED_PAYMENT")},
+			},
+			expectedOutcome:     xrpl.OutcomeTecFailed,
+			expectedResult:      "tecUNFUNDED_PAYMENT",
+			expectedSubmissions: 1,
+		},
+		{
+			name: "pass - expired once the last ledger sequence is validated",
+			responses: map[string][]mockResponse{
+				"server_info": {serverInfoLedgersResponse(100, "1-100"), serverInfoLedgersResponse(110, "1-110"), serverInfoLedgersResponse(120, "1-120")},
+				"submit":      {submitResponse("terQUEUED")},
+				"tx":          {txnNotFound},
+			},
+			expectedOutcome:     xrpl.OutcomeExpired,
+			expectedResult:      "terQUEUED",
+			expectedSubmissions: 2,
+		},
+		{
+			name: "pass - malformed transaction rejected",
+			responses: map[string][]mockResponse{
+				"server_info": {serverInfoLedgersResponse(100, "1-100")},
+				"submit":      {submitResponse("temBAD_FEE")},
+			},
+			expectedOutcome:     xrpl.OutcomeRejected,
+			expectedResult:      "temBAD_FEE",
+			expectedSubmissions: 1,
+		},
+		{
+			name: "pass - past sequence is tracked as it may be the transaction itself",
+			responses: map[string][]mockResponse{
+				"server_info": {serverInfoLedgersResponse(100, "1-100")},
+				"submit":      {submitResponse("tefPAST_SEQ")},
+				"tx":          {validatedTxResponse("tesSUCCESS")},
+			},
+			expectedOutcome:     xrpl.OutcomeSuccess,
+			expectedResult:      "tesSUCCESS",
+			expectedSubmissions: 1,
+		},
+		{
+			name: "fail - ledger history doesn't cover the last ledger sequence",
+			responses: map[string][]mockResponse{
+				"server_info": {serverInfoLedgersResponse(100, "1-100"), serverInfoLedgersResponse(121, "1-105,110-121")},
+				"submit":      {submitResponse("tesSUCCESS")},
+				"tx":          {txnNotFound},
+			},
+			expectedErr: ErrIncompleteLedgerHistory,
+		},
+		{
+			name: "fail - server error",
+			responses: map[string][]mockResponse{
+				"server_info": {serverInfoLedgersResponse(100, "1-100")},
+				"submit":      {submitResponse("tesSUCCESS")},
+				"tx":          {{err: &xrpl.XRPLError{Token: "tooBusy"}}},
+			},
+			expectedErr: xrpl.ErrTooBusy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := New(&mockExecutor{responses: tt.responses}, Config{RetryDelay: time.Millisecond})
+
+			res, err := e.SubmitTxBlobReliable(context.Background(), blob, false)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOutcome, res.Outcome)
+			require.Equal(t, tt.expectedResult, res.EngineResult)
+			require.Equal(t, tt.expectedSubmissions, res.Submissions)
+			require.NotEmpty(t, res.Hash)
+			if tt.expectedOutcome == xrpl.OutcomeSuccess || tt.expectedOutcome == xrpl.OutcomeTecFailed {
+				require.NotNil(t, res.Tx)
+				require.Equal(t, tt.expectedResult, res.Tx.Meta.TransactionResult)
+			} else {
+				require.Nil(t, res.Tx)
+			}
+		})
+	}
+}
+
+func TestEngine_SubmitTxBlobReliable_MissingLastLedgerSequence(t *testing.T) {
+	blob, err := binarycodec.Encode(transaction.FlatTransaction{
+		"TransactionType": "Payment",
+		"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"SigningPubKey":   "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+	})
+	require.NoError(t, err)
+
+	e := New(&mockExecutor{}, Config{})
+	_, err = e.SubmitTxBlobReliable(context.Background(), blob, false)
+	require.ErrorIs(t, err, ErrMissingLastLedgerSequenceInTransaction)
+}
+
+func TestLedgerRangeAvailable(t *testing.T) {
+	tests := []struct {
+		name            string
+		completeLedgers string
+		minLedger       uint32
+		maxLedger       uint32
+		expected        bool
+	}{
+		{name: "single range", completeLedgers: "32570-6595042", minLedger: 6595000, maxLedger: 6595042, expected: true},
+		{name: "range in a later interval", completeLedgers: "1-50,60-300", minLedger: 100, maxLedger: 200, expected: true},
+		{name: "gap in the range", completeLedgers: "1-150,152-300", minLedger: 100, maxLedger: 200, expected: false},
+		{name: "range not reached", completeLedgers: "1-199", minLedger: 100, maxLedger: 200, expected: false},
+		{name: "single ledger", completeLedgers: "100", minLedger: 100, maxLedger: 100, expected: true},
+		{name: "empty", completeLedgers: "empty", minLedger: 100, maxLedger: 200, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, ledgerRangeAvailable(tt.completeLedgers, tt.minLedger, tt.maxLedger))
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package engine
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// SequenceManager hands out the Sequence of the transactions of each account locally, so that several
+// transactions can be autofilled and submitted in a row without waiting for the previous ones to be applied.
+//
+// The next Sequence of an account is fetched once with account_info and then incremented for every
+// transaction. It's fetched again after a submission fails with tefPAST_SEQ or terPRE_SEQ, or after Reset.
+// Tickets added with AddTickets are used instead of a Sequence, lowest first, until the pool is empty.
+//
+// A SequenceManager is safe for concurrent use, and can be shared by several clients.
+type SequenceManager struct {
+	mu       sync.Mutex
+	accounts map[types.Address]*accountSequence
+}
+
+// accountSequence is the state of a single account. Its mutex is held while the Sequence is fetched,
+// so concurrent transactions of the account wait for a single account_info request.
+type accountSequence struct {
+	mu sync.Mutex
+	// next is the next Sequence to hand out, or 0 if it must be fetched.
+	next uint32
+	// tickets are the TicketSequences available to the account, in ascending order.
+	tickets []uint32
+}
+
+// NewSequenceManager returns a SequenceManager without any cached Sequence or Ticket.
+func NewSequenceManager() *SequenceManager {
+	return &SequenceManager{
+		accounts: make(map[types.Address]*accountSequence),
+	}
+}
+
+// account returns the state of the account, creating it if needed.
+func (m *SequenceManager) account(address types.Address) *accountSequence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	acc, ok := m.accounts[address]
+	if !ok {
+		acc = &accountSequence{}
+		m.accounts[address] = acc
+	}
+	return acc
+}
+
+// AddTickets adds Tickets owned by the account to its pool. Transactions of the account use them instead
+// of a Sequence until the pool is empty. Tickets already in the pool are ignored.
+func (m *SequenceManager) AddTickets(address types.Address, tickets ...uint32) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	for _, ticket := range tickets {
+		if !slices.Contains(acc.tickets, ticket) {
+			acc.tickets = append(acc.tickets, ticket)
+		}
+	}
+	slices.Sort(acc.tickets)
+}
+
+// Tickets returns the number of Tickets left in the pool of the account.
+func (m *SequenceManager) Tickets(address types.Address) int {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	return len(acc.tickets)
+}
+
+// Reset forgets the cached Sequence of the account, so it's fetched again for its next transaction.
+// Its Tickets are kept.
+func (m *SequenceManager) Reset(address types.Address) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	acc.next = 0
+}
+
+// next returns the next Ticket of the account if its pool isn't empty, or its next Sequence otherwise,
+// fetching it with fetch if it isn't cached.
+func (m *SequenceManager) next(ctx context.Context, address types.Address, fetch func(context.Context) (uint32, error)) (sequence, ticket uint32, err error) {
+	acc := m.account(address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	if len(acc.tickets) > 0 {
+		ticket = acc.tickets[0]
+		acc.tickets = acc.tickets[1:]
+		return 0, ticket, nil
+	}
+
+	if acc.next == 0 {
+		acc.next, err = fetch(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	sequence = acc.next
+	acc.next++
+	return sequence, 0, nil
+}
+
+// setManagedSequence sets the Sequence, or the TicketSequence, of the transaction from the sequence manager.
+// A transaction that already has a TicketSequence gets a zero Sequence.
+func (e *Engine) setManagedSequence(ctx context.Context, tx *transaction.FlatTransaction, address types.Address) error {
+	if _, ok := (*tx)["TicketSequence"]; ok {
+		(*tx)["Sequence"] = uint32(0)
+		return nil
+	}
+
+	sequence, ticket, err := e.cfg.Sequences.next(ctx, address, func(ctx context.Context) (uint32, error) {
+		res, err := e.getAccountInfo(ctx, &account.InfoRequest{
+			Account:     address,
+			LedgerIndex: common.LedgerTitle("current"),
+		})
+		if err != nil {
+			return 0, err
+		}
+		e.cfg.Logger.DebugContext(ctx, "fetched account Sequence", "account", address, "sequence", res.AccountData.Sequence)
+		return uint32(res.AccountData.Sequence), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	(*tx)["Sequence"] = sequence
+	if ticket != 0 {
+		(*tx)["TicketSequence"] = ticket
+		e.cfg.Logger.DebugContext(ctx, "autofilled TicketSequence", "account", address, "ticket_sequence", ticket)
+		return nil
+	}
+	e.cfg.Logger.DebugContext(ctx, "autofilled Sequence", "account", address, "sequence", sequence)
+	return nil
+}
+
+// resyncSequence forgets the cached Sequence of the account of a submitted transaction when its result
+// shows the Sequence is out of sync with the ledger.
+func (e *Engine) resyncSequence(ctx context.Context, tx transaction.FlatTransaction, engineResult string) {
+	if e.cfg.Sequences == nil || (engineResult != "tefPAST_SEQ" && engineResult != "terPRE_SEQ") {
+		return
+	}
+	address, ok := tx["Account"].(string)
+	if !ok {
+		return
+	}
+	e.cfg.Sequences.Reset(types.Address(address))
+	e.cfg.Logger.DebugContext(ctx, "resynchronizing account Sequence", "account", address, "engine_result", engineResult)
+}
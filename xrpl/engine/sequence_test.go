@@ -0,0 +1,130 @@
+package engine
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequenceManager_next(t *testing.T) {
+	m := NewSequenceManager()
+
+	var fetches atomic.Int32
+	fetch := func(context.Context) (uint32, error) {
+		fetches.Add(1)
+		return 42, nil
+	}
+
+	var mu sync.Mutex
+	var sequences []uint32
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sequence, ticket, err := m.next(context.Background(), "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", fetch)
+			require.NoError(t, err)
+			require.Zero(t, ticket)
+			mu.Lock()
+			sequences = append(sequences, sequence)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	slices.Sort(sequences)
+	for i, sequence := range sequences {
+		require.Equal(t, uint32(42+i), sequence)
+	}
+	require.Equal(t, int32(1), fetches.Load())
+
+	// Other accounts have their own Sequence.
+	sequence, _, err := m.next(context.Background(), "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", fetch)
+	require.NoError(t, err)
+	require.Equal(t, uint32(42), sequence)
+
+	m.Reset("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf")
+	sequence, _, err = m.next(context.Background(), "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", fetch)
+	require.NoError(t, err)
+	require.Equal(t, uint32(42), sequence)
+	require.Equal(t, int32(3), fetches.Load())
+}
+
+func TestSequenceManager_Tickets(t *testing.T) {
+	m := NewSequenceManager()
+	m.AddTickets("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", 12, 10)
+	m.AddTickets("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", 11, 10)
+	require.Equal(t, 3, m.Tickets("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"))
+
+	fetch := func(context.Context) (uint32, error) { return 42, nil }
+	for _, expected := range []uint32{10, 11, 12} {
+		sequence, ticket, err := m.next(context.Background(), "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", fetch)
+		require.NoError(t, err)
+		require.Zero(t, sequence)
+		require.Equal(t, expected, ticket)
+	}
+	require.Zero(t, m.Tickets("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"))
+
+	sequence, ticket, err := m.next(context.Background(), "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", fetch)
+	require.NoError(t, err)
+	require.Equal(t, uint32(42), sequence)
+	require.Zero(t, ticket)
+}
+
+func TestEngine_SequenceManager(t *testing.T) {
+	accountInfo := func(sequence uint32) mockResponse {
+		return mockResponse{result: map[string]any{"account_data": map[string]any{"Sequence": sequence}}}
+	}
+	newTx := func() transaction.FlatTransaction {
+		return transaction.FlatTransaction{
+			"TransactionType":    "AccountSet",
+			"Account":            "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			"Fee":                "10",
+			"LastLedgerSequence": uint32(200),
+		}
+	}
+
+	exec := &mockExecutor{responses: map[string][]mockResponse{
+		"account_info": {accountInfo(42), accountInfo(40)},
+		"submit":       {{result: map[string]any{"engine_result": "tefPAST_SEQ"}}},
+	}}
+	m := NewSequenceManager()
+	e := New(exec, Config{Sequences: m})
+
+	// Consecutive transactions get consecutive Sequences from a single account_info request.
+	for _, expected := range []uint32{42, 43} {
+		tx := newTx()
+		require.NoError(t, e.Autofill(context.Background(), &tx))
+		require.Equal(t, expected, tx["Sequence"])
+	}
+	require.Equal(t, []string{"account_info"}, exec.calls)
+
+	// Tickets are used while there are any left.
+	m.AddTickets("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", 7)
+	tx := newTx()
+	require.NoError(t, e.Autofill(context.Background(), &tx))
+	require.Equal(t, uint32(0), tx["Sequence"])
+	require.Equal(t, uint32(7), tx["TicketSequence"])
+
+	// A past Sequence makes the next transaction fetch the Sequence again.
+	tx = newTx()
+	require.NoError(t, e.Autofill(context.Background(), &tx))
+	require.Equal(t, uint32(44), tx["Sequence"])
+	tx["SigningPubKey"] = "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A"
+	blob, err := binarycodec.Encode(tx)
+	require.NoError(t, err)
+	res, err := e.SubmitTxBlob(context.Background(), blob, false)
+	require.NoError(t, err)
+	require.Equal(t, "tefPAST_SEQ", res.EngineResult)
+
+	tx = newTx()
+	require.NoError(t, e.Autofill(context.Background(), &tx))
+	require.Equal(t, uint32(40), tx["Sequence"])
+	require.Equal(t, []string{"account_info", "submit", "account_info"}, exec.calls)
+}
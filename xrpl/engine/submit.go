@@ -0,0 +1,183 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// SubmitTxBlob submits a pre-signed transaction blob.
+// It decodes the blob to confirm that it contains either a signature or a signing public key.
+func (e *Engine) SubmitTxBlob(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitResponse, error) {
+	tx, err := binarycodec.Decode(txBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	_, okTxSig := tx["TxSignature"].(string)
+	_, okPubKey := tx["SigningPubKey"].(string)
+
+	if !okTxSig && !okPubKey {
+		return nil, ErrMissingTxSignatureOrSigningPubKey
+	}
+
+	res, err := execute[requests.SubmitResponse](ctx, e.exec, &requests.SubmitRequest{
+		TxBlob:   txBlob,
+		FailHard: failHard,
+	})
+	if err != nil {
+		return nil, err
+	}
+	e.resyncSequence(ctx, tx, res.EngineResult)
+	return res, nil
+}
+
+// SubmitMultisigned submits a multisigned transaction blob.
+// It decodes the blob to confirm that none of its signers is empty.
+func (e *Engine) SubmitMultisigned(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
+	tx, err := binarycodec.Decode(txBlob)
+	if err != nil {
+		return nil, err
+	}
+	signers, okSigners := tx["Signers"].([]interface{})
+
+	if okSigners && len(signers) > 0 {
+		for _, sig := range signers {
+			signer := sig.(map[string]any)
+			signerData := signer["Signer"].(map[string]any)
+			if signerData["SigningPubKey"] == "" && signerData["TxnSignature"] == "" {
+				return nil, ErrSignerDataIsEmpty
+			}
+		}
+	}
+
+	res, err := execute[requests.SubmitMultisignedResponse](ctx, e.exec, &requests.SubmitMultisignedRequest{
+		Tx:       tx,
+		FailHard: failHard,
+	})
+	if err != nil {
+		return nil, err
+	}
+	e.resyncSequence(ctx, tx, res.EngineResult)
+	return res, nil
+}
+
+// Simulate executes an unsigned transaction against the current open ledger without submitting it,
+// returning its engine result and metadata. If autofill is true, the transaction is autofilled first,
+// otherwise the server fills its missing Fee, Sequence, SigningPubKey and NetworkID.
+func (e *Engine) Simulate(ctx context.Context, tx transaction.FlatTransaction, autofill bool) (*requests.SimulateResponse, error) {
+	req := &requests.SimulateRequest{Tx: tx}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if autofill {
+		if err := e.Autofill(ctx, &tx); err != nil {
+			return nil, err
+		}
+	}
+
+	return execute[requests.SimulateResponse](ctx, e.exec, req)
+}
+
+// SignTx ensures the transaction is fully signed and returns the transaction blob.
+// If the transaction is already signed, it encodes and returns it. Otherwise, it autofills (if enabled)
+// and signs the transaction using the provided wallet.
+func (e *Engine) SignTx(ctx context.Context, tx transaction.FlatTransaction, autofill bool, wallet *wallet.Wallet) (string, error) {
+	// Check if the transaction is already signed: both fields must be non-empty.
+	sig, sigOk := tx["TxnSignature"].(string)
+	pubKey, pubKeyOk := tx["SigningPubKey"].(string)
+	if sigOk && sig != "" && pubKeyOk && pubKey != "" {
+		return binarycodec.Encode(tx)
+	}
+
+	// If not signed, ensure a wallet is provided.
+	if wallet == nil {
+		return "", ErrMissingWallet
+	}
+
+	// Optionally autofill the transaction.
+	if autofill {
+		if err := e.Autofill(ctx, &tx); err != nil {
+			return "", err
+		}
+	}
+
+	txBlob, _, err := wallet.Sign(tx)
+	if err != nil {
+		return "", err
+	}
+	return txBlob, nil
+}
+
+// WaitForTransaction looks the transaction up until it's validated, the last validated ledger reaches
+// lastLedgerSequence or the configured number of retries is exhausted.
+// A transaction the server doesn't know about yet is retried after the configured delay.
+func (e *Engine) WaitForTransaction(ctx context.Context, txHash string, lastLedgerSequence uint32) (*requests.TxResponse, error) {
+	var txResponse *requests.TxResponse
+
+	for i := 0; i < e.cfg.MaxRetries; i++ {
+		// Get the current ledger index
+		currentLedger, err := e.getLedgerIndex(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if the transaction has been included in the current ledger
+		if currentLedger.Int() >= int(lastLedgerSequence) {
+			e.cfg.Logger.DebugContext(ctx, "last ledger sequence reached while waiting for transaction",
+				"hash", txHash,
+				"ledger_index", currentLedger.Uint32(),
+				"last_ledger_sequence", lastLedgerSequence,
+			)
+			break
+		}
+
+		// Request the transaction from the server
+		res, err := execute[requests.TxResponse](ctx, e.exec, &requests.TxRequest{
+			Transaction: txHash,
+		})
+		if err != nil && !errors.Is(err, xrpl.ErrTxnNotFound) {
+			return nil, err
+		}
+
+		if res != nil {
+			txResponse = res
+
+			// A validated transaction is final.
+			if txResponse.Validated {
+				return txResponse, nil
+			}
+
+			// Check if the transaction has been included in the current ledger
+			if txResponse.LedgerIndex.Int() >= int(lastLedgerSequence) {
+				break
+			}
+		}
+
+		e.cfg.Logger.DebugContext(ctx, "transaction not validated yet, retrying",
+			"hash", txHash,
+			"attempt", i+1,
+			"delay", e.cfg.RetryDelay,
+		)
+
+		// Wait for the retry delay before retrying
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(e.cfg.RetryDelay):
+		}
+	}
+
+	if txResponse == nil {
+		return nil, ErrTransactionNotFound
+	}
+
+	return txResponse, nil
+}
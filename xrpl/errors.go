@@ -6,3 +6,73 @@ var (
 	// ErrNoTxToMultisign is returned when no transaction blobs are provided to Multisign.
 	ErrNoTxToMultisign = errors.New("no transaction to multisign")
 )
+
+// XRPL server errors. They match any XRPLError with the same token when used with errors.Is.
+var (
+	// ErrActMalformed is returned by the server when an account address is malformed.
+	ErrActMalformed = &XRPLError{Token: "actMalformed"}
+	// ErrActNotFound is returned by the server when an account does not exist in the requested ledger.
+	ErrActNotFound = &XRPLError{Token: "actNotFound"}
+	// ErrAmendmentBlocked is returned by the server when it is amendment blocked.
+	ErrAmendmentBlocked = &XRPLError{Token: "amendmentBlocked"}
+	// ErrEntryNotFound is returned by the server when a ledger entry does not exist.
+	ErrEntryNotFound = &XRPLError{Token: "entryNotFound"}
+	// ErrHighFee is returned by the server when the transaction cost exceeds the allowed fee.
+	ErrHighFee = &XRPLError{Token: "highFee"}
+	// ErrInternal is returned by the server when an internal error occurs.
+	ErrInternal = &XRPLError{Token: "internal"}
+	// ErrInvalidParams is returned by the server when a request has missing or malformed parameters.
+	ErrInvalidParams = &XRPLError{Token: "invalidParams"}
+	// ErrLgrIdxMalformed is returned by the server when a ledger index is malformed.
+	ErrLgrIdxMalformed = &XRPLError{Token: "lgrIdxMalformed"}
+	// ErrLgrNotFound is returned by the server when the requested ledger is not available.
+	ErrLgrNotFound = &XRPLError{Token: "lgrNotFound"}
+	// ErrNoClosed is returned by the server when it has no closed ledger.
+	ErrNoClosed = &XRPLError{Token: "noClosed"}
+	// ErrNoCurrent is returned by the server when it has no current ledger.
+	ErrNoCurrent = &XRPLError{Token: "noCurrent"}
+	// ErrNoNetwork is returned by the server when it is not connected to the network.
+	ErrNoNetwork = &XRPLError{Token: "noNetwork"}
+	// ErrNotSynced is returned by the server when it is not synced with the network.
+	ErrNotSynced = &XRPLError{Token: "notSynced"}
+	// ErrSlowDown is returned by the server when the client is sending too many requests.
+	ErrSlowDown = &XRPLError{Token: "slowDown"}
+	// ErrTooBusy is returned by the server when it is under too much load to serve the request.
+	ErrTooBusy = &XRPLError{Token: "tooBusy"}
+	// ErrTxnNotFound is returned by the server when a transaction is not known to it.
+	ErrTxnNotFound = &XRPLError{Token: "txnNotFound"}
+	// ErrUnknownCmd is returned by the server when the requested method does not exist.
+	ErrUnknownCmd = &XRPLError{Token: "unknownCmd"}
+)
+
+// Dynamic errors
+
+// XRPLError is an error response returned by an XRPL server.
+//
+//revive:disable-next-line:exported
+type XRPLError struct {
+	// Token is the error code returned by the server, e.g. "actNotFound".
+	Token string
+	// Code is the numeric error code, if returned by the server.
+	Code int
+	// Message is the human readable description of the error, if returned by the server.
+	Message string
+	// Status is the status of the response, usually "error".
+	Status string
+	// Request is the request echoed back by the server, if any.
+	Request map[string]any
+}
+
+// Error implements the error interface for XRPLError
+func (e *XRPLError) Error() string {
+	if e.Message == "" {
+		return e.Token
+	}
+	return e.Token + ": " + e.Message
+}
+
+// Is reports whether target is an XRPLError with the same token.
+func (e *XRPLError) Is(target error) bool {
+	t, ok := target.(*XRPLError)
+	return ok && t.Token == e.Token
+}
@@ -0,0 +1,55 @@
+package xrpl
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXRPLError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		target   error
+		match    bool
+		expected string
+	}{
+		{
+			name:     "pass - matches sentinel with the same token",
+			err:      &XRPLError{Token: "actNotFound", Code: 19, Message: "Account not found."},
+			target:   ErrActNotFound,
+			match:    true,
+			expected: "actNotFound: Account not found.",
+		},
+		{
+			name:     "pass - matches wrapped error",
+			err:      fmt.Errorf("request failed: %w", &XRPLError{Token: "tooBusy"}),
+			target:   ErrTooBusy,
+			match:    true,
+			expected: "request failed: tooBusy",
+		},
+		{
+			name:     "fail - different token",
+			err:      &XRPLError{Token: "lgrNotFound"},
+			target:   ErrTxnNotFound,
+			match:    false,
+			expected: "lgrNotFound",
+		},
+		{
+			name:     "fail - different error type",
+			err:      errors.New("txnNotFound"),
+			target:   ErrTxnNotFound,
+			match:    false,
+			expected: "txnNotFound",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.match, errors.Is(tc.err, tc.target))
+			require.EqualError(t, tc.err, tc.expected)
+		})
+	}
+}
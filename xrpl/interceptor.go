@@ -0,0 +1,68 @@
+package xrpl
+
+import (
+	"context"
+	"time"
+)
+
+// RequestEvent describes a request sent by a client to an XRPL server.
+type RequestEvent struct {
+	// Method is the method of the request, e.g. "account_info".
+	Method string
+	// ID identifies the request within its client. It's the request ID for the websocket client,
+	// and a sequence number of the HTTP requests sent for the rpc client.
+	ID uint64
+	// Payload is the raw request sent to the server.
+	Payload []byte
+}
+
+// ResponseEvent describes the outcome of a request sent by a client to an XRPL server.
+type ResponseEvent struct {
+	// Request is the request this event is the outcome of.
+	Request *RequestEvent
+	// Latency is the time elapsed between sending the request and getting its response or error.
+	Latency time.Duration
+	// Payload is the raw response received from the server, if any.
+	Payload []byte
+	// Err is the error the request failed with, if any. Error responses of the server are *XRPLError.
+	Err error
+}
+
+// Interceptor hooks into the requests sent by a client, for example for logging, tracing or metrics.
+// Any of its hooks can be nil.
+type Interceptor struct {
+	// BeforeRequest is called before a request is sent. The context it returns is used to send the
+	// request and is passed to AfterResponse or OnError, so it can carry a tracing span.
+	BeforeRequest func(ctx context.Context, req *RequestEvent) context.Context
+	// AfterResponse is called when a response is received without error.
+	AfterResponse func(ctx context.Context, res *ResponseEvent)
+	// OnError is called when a request fails, including when the server answers with an error.
+	OnError func(ctx context.Context, res *ResponseEvent)
+}
+
+// Interceptors is a chain of interceptors. BeforeRequest hooks are called in order, and AfterResponse
+// and OnError hooks in reverse order, so that the first interceptor wraps the others.
+type Interceptors []Interceptor
+
+// BeforeRequest calls the BeforeRequest hooks of the chain and returns the resulting context.
+func (is Interceptors) BeforeRequest(ctx context.Context, req *RequestEvent) context.Context {
+	for _, i := range is {
+		if i.BeforeRequest != nil {
+			ctx = i.BeforeRequest(ctx, req)
+		}
+	}
+	return ctx
+}
+
+// Complete calls the AfterResponse hooks of the chain, or its OnError hooks if res has an error.
+func (is Interceptors) Complete(ctx context.Context, res *ResponseEvent) {
+	for i := len(is) - 1; i >= 0; i-- {
+		if res.Err != nil {
+			if is[i].OnError != nil {
+				is[i].OnError(ctx, res)
+			}
+		} else if is[i].AfterResponse != nil {
+			is[i].AfterResponse(ctx, res)
+		}
+	}
+}
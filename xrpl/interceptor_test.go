@@ -0,0 +1,64 @@
+package xrpl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type interceptorKey struct{}
+
+// recordingInterceptor appends the hooks it gets called for to calls, prefixed by name.
+// Its BeforeRequest hook adds name to the context, and the other hooks record the context value.
+func recordingInterceptor(name string, calls *[]string) Interceptor {
+	return Interceptor{
+		BeforeRequest: func(ctx context.Context, _ *RequestEvent) context.Context {
+			*calls = append(*calls, name+":before")
+			prev, _ := ctx.Value(interceptorKey{}).(string)
+			return context.WithValue(ctx, interceptorKey{}, prev+name)
+		},
+		AfterResponse: func(ctx context.Context, _ *ResponseEvent) {
+			*calls = append(*calls, name+":after:"+ctx.Value(interceptorKey{}).(string))
+		},
+		OnError: func(ctx context.Context, _ *ResponseEvent) {
+			*calls = append(*calls, name+":error:"+ctx.Value(interceptorKey{}).(string))
+		},
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected []string
+	}{
+		{
+			name:     "pass - response",
+			expected: []string{"a:before", "b:before", "b:after:ab", "a:after:ab"},
+		},
+		{
+			name:     "pass - error",
+			err:      errors.New("request failed"),
+			expected: []string{"a:before", "b:before", "b:error:ab", "a:error:ab"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []string
+			chain := Interceptors{
+				recordingInterceptor("a", &calls),
+				{}, // interceptors without hooks are skipped
+				recordingInterceptor("b", &calls),
+			}
+
+			req := &RequestEvent{Method: "ping", ID: 1}
+			ctx := chain.BeforeRequest(context.Background(), req)
+			chain.Complete(ctx, &ResponseEvent{Request: req, Err: tc.err})
+
+			require.Equal(t, tc.expected, calls)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package ledger
 
 import (
+	"encoding/json"
+
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -126,6 +128,36 @@ type AuctionSlot struct {
 	Expiration uint32
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for AuctionSlot.
+func (a *AuctionSlot) UnmarshalJSON(data []byte) error {
+	type auctionSlotHelper struct {
+		Account       types.Address
+		AuthAccounts  []AuthAccounts `json:",omitempty"`
+		DiscountedFee uint16
+		Price         json.RawMessage
+		Expiration    uint32
+	}
+	var h auctionSlotHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*a = AuctionSlot{
+		Account:       h.Account,
+		AuthAccounts:  h.AuthAccounts,
+		DiscountedFee: h.DiscountedFee,
+		Expiration:    h.Expiration,
+	}
+	if len(h.Price) == 0 || string(h.Price) == "null" {
+		return nil
+	}
+	price, err := types.UnmarshalCurrencyAmount(h.Price)
+	if err != nil {
+		return err
+	}
+	a.Price = price
+	return nil
+}
+
 // ---------------------------------------------
 // AuthAccounts Object
 // ---------------------------------------------
@@ -179,3 +211,47 @@ type VoteEntry struct {
 func (*AMM) EntryType() EntryType {
 	return AMMEntry
 }
+
+// UnmarshalJSON implements custom JSON unmarshalling for AMM.
+func (a *AMM) UnmarshalJSON(data []byte) error {
+	type ammHelper struct {
+		Index             types.Hash256 `json:"index,omitempty"`
+		LedgerEntryType   string        `json:",omitempty"`
+		Flags             uint32
+		Account           types.Address
+		Asset             Asset
+		Asset2            Asset
+		AuctionSlot       AuctionSlot `json:",omitempty"`
+		LPTokenBalance    json.RawMessage
+		TradingFee        uint16
+		VoteSlots         []VoteSlots   `json:",omitempty"`
+		PreviousTxnID     types.Hash256 `json:",omitempty"`
+		PreviousTxnLgrSeq uint32        `json:",omitempty"`
+	}
+	var h ammHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*a = AMM{
+		Index:             h.Index,
+		LedgerEntryType:   h.LedgerEntryType,
+		Flags:             h.Flags,
+		Account:           h.Account,
+		Asset:             h.Asset,
+		Asset2:            h.Asset2,
+		AuctionSlot:       h.AuctionSlot,
+		TradingFee:        h.TradingFee,
+		VoteSlots:         h.VoteSlots,
+		PreviousTxnID:     h.PreviousTxnID,
+		PreviousTxnLgrSeq: h.PreviousTxnLgrSeq,
+	}
+	if len(h.LPTokenBalance) == 0 || string(h.LPTokenBalance) == "null" {
+		return nil
+	}
+	balance, err := types.UnmarshalCurrencyAmount(h.LPTokenBalance)
+	if err != nil {
+		return err
+	}
+	a.LPTokenBalance = balance
+	return nil
+}
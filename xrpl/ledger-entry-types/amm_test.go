@@ -1,10 +1,13 @@
 package ledger
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAssetFlatten(t *testing.T) {
@@ -73,3 +76,26 @@ func TestAuthAccount_Flatten(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestAMM_UnmarshalJSON(t *testing.T) {
+	var amm AMM
+	err := json.Unmarshal([]byte(`{
+	"LedgerEntryType": "AMM",
+	"Account": "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S",
+	"Asset": {"currency": "XRP"},
+	"Asset2": {"currency": "TST", "issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"},
+	"AuctionSlot": {
+		"Account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+		"DiscountedFee": 0,
+		"Expiration": 721870180,
+		"Price": {"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", "issuer": "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S", "value": "0.8696263565463045"}
+	},
+	"LPTokenBalance": {"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", "issuer": "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S", "value": "71150.53584131501"},
+	"TradingFee": 600
+}`), &amm)
+	require.NoError(t, err)
+	require.Equal(t, "TST", amm.Asset2.Currency)
+	require.Equal(t, uint16(600), amm.TradingFee)
+	require.Equal(t, "71150.53584131501", amm.LPTokenBalance.(types.IssuedCurrencyAmount).Value)
+	require.Equal(t, "0.8696263565463045", amm.AuctionSlot.Price.(types.IssuedCurrencyAmount).Value)
+}
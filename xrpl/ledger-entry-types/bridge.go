@@ -1,6 +1,23 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// XChainBridge identifies a cross-chain bridge by its door accounts and the assets they lock and issue.
+// Unlike types.XChainBridge, the assets are issues with a currency and an issuer, as servers return them.
+type XChainBridge struct {
+	// The door account on the locking chain.
+	LockingChainDoor types.Address
+	// The asset that is locked and unlocked on the locking chain.
+	LockingChainIssue Asset
+	// The door account on the issuing chain.
+	IssuingChainDoor types.Address
+	// The asset that is minted and burned on the issuing chain.
+	IssuingChainIssue Asset
+}
 
 // Bridge ledger entry represents a single cross-chain bridge that connects the XRP Ledger with
 // another blockchain, such as its sidechain, and enables value in the form of XRP and other tokens (IOUs) to move efficiently between the two blockchains.
@@ -62,7 +79,7 @@ type Bridge struct {
 	// It is incremented every time a successful XChainAccountCreateCommit transaction is run for the source chain.
 	XChainAccountCreateCount string
 	// The door accounts and assets of the bridge this object correlates to.
-	XChainBridge types.XChainBridge
+	XChainBridge XChainBridge
 	// The value of the next XChainClaimID to be created.
 	XChainClaimID string
 }
@@ -71,3 +88,49 @@ type Bridge struct {
 func (*Bridge) EntryType() EntryType {
 	return BridgeEntry
 }
+
+// UnmarshalJSON implements custom JSON unmarshalling for Bridge.
+func (b *Bridge) UnmarshalJSON(data []byte) error {
+	type bridgeHelper struct {
+		Index                    types.Hash256 `json:"index,omitempty"`
+		LedgerEntryType          string
+		Flags                    uint32
+		Account                  types.Address
+		MinAccountCreateAmount   json.RawMessage `json:",omitempty"`
+		SignatureReward          json.RawMessage
+		XChainAccountClaimCount  string
+		XChainAccountCreateCount string
+		XChainBridge             XChainBridge
+		XChainClaimID            string
+	}
+	var h bridgeHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*b = Bridge{
+		Index:                    h.Index,
+		LedgerEntryType:          h.LedgerEntryType,
+		Flags:                    h.Flags,
+		Account:                  h.Account,
+		XChainAccountClaimCount:  h.XChainAccountClaimCount,
+		XChainAccountCreateCount: h.XChainAccountCreateCount,
+		XChainBridge:             h.XChainBridge,
+		XChainClaimID:            h.XChainClaimID,
+	}
+	if len(h.MinAccountCreateAmount) > 0 && string(h.MinAccountCreateAmount) != "null" {
+		amount, err := types.UnmarshalCurrencyAmount(h.MinAccountCreateAmount)
+		if err != nil {
+			return err
+		}
+		b.MinAccountCreateAmount = amount
+	}
+	if len(h.SignatureReward) == 0 || string(h.SignatureReward) == "null" {
+		return nil
+	}
+	reward, err := types.UnmarshalCurrencyAmount(h.SignatureReward)
+	if err != nil {
+		return err
+	}
+	b.SignatureReward = reward
+	return nil
+}
@@ -1,6 +1,8 @@
 package ledger
 
 import (
+	"encoding/json"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,3 +12,29 @@ func TestBridge_EntryType(t *testing.T) {
 	entry := &Bridge{}
 	assert.Equal(t, BridgeEntry, entry.EntryType())
 }
+
+func TestBridge_UnmarshalJSON(t *testing.T) {
+	var bridge Bridge
+	err := json.Unmarshal([]byte(`{
+	"Account": "r3nCVTbZGGYoWvZ58BcxDmiMUU7ChMa1eC",
+	"LedgerEntryType": "Bridge",
+	"MinAccountCreateAmount": "2000000000",
+	"SignatureReward": "204",
+	"XChainBridge": {
+		"IssuingChainDoor": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"IssuingChainIssue": {"currency": "XRP"},
+		"LockingChainDoor": "r3nCVTbZGGYoWvZ58BcxDmiMUU7ChMa1eC",
+		"LockingChainIssue": {"currency": "XRP"}
+	},
+	"XChainClaimID": "1"
+}`), &bridge)
+	assert.NoError(t, err)
+	assert.Equal(t, types.XRPCurrencyAmount(2000000000), bridge.MinAccountCreateAmount)
+	assert.Equal(t, types.XRPCurrencyAmount(204), bridge.SignatureReward)
+	assert.Equal(t, XChainBridge{
+		LockingChainDoor:  "r3nCVTbZGGYoWvZ58BcxDmiMUU7ChMa1eC",
+		LockingChainIssue: Asset{Currency: "XRP"},
+		IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		IssuingChainIssue: Asset{Currency: "XRP"},
+	}, bridge.XChainBridge)
+}
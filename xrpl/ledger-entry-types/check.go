@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // Check represents a check ledger entry, similar to a paper personal check, which can be cashed by its destination to debit the sender's balance. (Added by the Checks amendment.)
 type Check struct {
@@ -47,3 +51,53 @@ type Check struct {
 func (*Check) EntryType() EntryType {
 	return CheckEntry
 }
+
+// UnmarshalJSON implements custom JSON unmarshalling for Check.
+func (c *Check) UnmarshalJSON(data []byte) error {
+	type checkHelper struct {
+		Index             types.Hash256 `json:"index,omitempty"`
+		LedgerEntryType   EntryType
+		Flags             uint32
+		Account           types.Address
+		Destination       types.Address
+		DestinationNode   string        `json:",omitempty"`
+		DestinationTag    uint32        `json:",omitempty"`
+		Expiration        uint32        `json:",omitempty"`
+		InvoiceID         types.Hash256 `json:",omitempty"`
+		OwnerNode         string
+		PreviousTxnID     types.Hash256
+		PreviousTxnLgrSeq uint32
+		SendMax           json.RawMessage
+		Sequence          uint32
+		SourceTag         uint32 `json:",omitempty"`
+	}
+	var h checkHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*c = Check{
+		Index:             h.Index,
+		LedgerEntryType:   h.LedgerEntryType,
+		Flags:             h.Flags,
+		Account:           h.Account,
+		Destination:       h.Destination,
+		DestinationNode:   h.DestinationNode,
+		DestinationTag:    h.DestinationTag,
+		Expiration:        h.Expiration,
+		InvoiceID:         h.InvoiceID,
+		OwnerNode:         h.OwnerNode,
+		PreviousTxnID:     h.PreviousTxnID,
+		PreviousTxnLgrSeq: h.PreviousTxnLgrSeq,
+		Sequence:          h.Sequence,
+		SourceTag:         h.SourceTag,
+	}
+	if len(h.SendMax) == 0 || string(h.SendMax) == "null" {
+		return nil
+	}
+	sendMax, err := types.UnmarshalCurrencyAmount(h.SendMax)
+	if err != nil {
+		return err
+	}
+	c.SendMax = sendMax
+	return nil
+}
@@ -1,9 +1,11 @@
 package ledger
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -48,3 +50,17 @@ func TestCheck_EntryType(t *testing.T) {
 	s := &Check{}
 	require.Equal(t, s.EntryType(), CheckEntry)
 }
+
+func TestCheck_UnmarshalJSON(t *testing.T) {
+	var check Check
+	err := json.Unmarshal([]byte(`{
+	"LedgerEntryType": "Check",
+	"Account": "rUn84CUYbNjRoTQ6mSW7BVJPSVJNLb1QLo",
+	"Destination": "rfkE1aSy9G8Upk4JssnwBxhEv5p4mn2KTy",
+	"SendMax": {"currency": "USD", "issuer": "rfkE1aSy9G8Upk4JssnwBxhEv5p4mn2KTy", "value": "10"},
+	"Sequence": 2
+}`), &check)
+	require.NoError(t, err)
+	require.Equal(t, types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rfkE1aSy9G8Upk4JssnwBxhEv5p4mn2KTy", Value: "10"}, check.SendMax)
+	require.Equal(t, uint32(2), check.Sequence)
+}
@@ -100,9 +100,9 @@ func (e *Escrow) UnmarshalJSON(data []byte) error {
 		OwnerNode         string
 		PreviousTxnID     types.Hash256
 		PreviousTxnLgrSeq uint32
-		SourceTag         uint32 `json:",omitempty"`
-		TransferRate      uint32 `json:",omitempty"`
-		IssuerNode        uint64 `json:",omitempty"`
+		SourceTag         uint32          `json:",omitempty"`
+		TransferRate      uint32          `json:",omitempty"`
+		IssuerNode        json.RawMessage `json:",omitempty"`
 	}
 	var h escrowHelper
 	if err := json.Unmarshal(data, &h); err != nil {
@@ -124,8 +124,12 @@ func (e *Escrow) UnmarshalJSON(data []byte) error {
 		PreviousTxnLgrSeq: h.PreviousTxnLgrSeq,
 		SourceTag:         h.SourceTag,
 		TransferRate:      h.TransferRate,
-		IssuerNode:        h.IssuerNode,
 	}
+	issuerNode, err := unmarshalUint64(h.IssuerNode, 16)
+	if err != nil {
+		return err
+	}
+	e.IssuerNode = issuerNode
 	amount, err := types.UnmarshalCurrencyAmount(h.Amount)
 	if err != nil {
 		return err
@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
@@ -158,3 +159,18 @@ func TestEscrowIssuedAmountSerialization(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestEscrow_UnmarshalJSON(t *testing.T) {
+	var escrow Escrow
+	err := json.Unmarshal([]byte(`{
+	"Account": "rA5xJmoc5zgrxRLx8aQBxHAV1x5Xg3gUKB",
+	"Amount": "10000",
+	"Destination": "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX",
+	"IssuerNode": "1a",
+	"LedgerEntryType": "Escrow",
+	"OwnerNode": "0"
+}`), &escrow)
+	require.NoError(t, err)
+	require.Equal(t, types.XRPCurrencyAmount(10000), escrow.Amount)
+	require.Equal(t, uint64(0x1a), escrow.IssuerNode)
+}
@@ -1,5 +1,10 @@
 package ledger
 
+import (
+	"encoding/json"
+	"strconv"
+)
+
 // EntryType represents the type of a ledger entry as a string identifier.
 type EntryType string
 
@@ -116,3 +121,21 @@ func EmptyLedgerObject(t string) (Object, error) {
 		Type: t,
 	}
 }
+
+// unmarshalUint64 decodes a UInt64 field of a ledger entry. Servers send them as strings, in base 10 for
+// amounts and in hex for the other fields, while the entries of this package are encoded with JSON numbers,
+// which are decoded too. A missing field is decoded as 0.
+func unmarshalUint64(data json.RawMessage, base int) (uint64, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return 0, nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var n uint64
+		if err := json.Unmarshal(data, &n); err != nil {
+			return 0, err
+		}
+		return n, nil
+	}
+	return strconv.ParseUint(s, base, 64)
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 const (
 	// If enabled, indicates that the MPT owned by this account is currently locked and cannot be used in any XRP transactions other than sending value back to the issuer.
@@ -43,6 +47,44 @@ func (*MPToken) EntryType() EntryType {
 	return MPTokenEntry
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for MPToken.
+func (c *MPToken) UnmarshalJSON(data []byte) error {
+	type mpTokenHelper struct {
+		Index             types.Hash256 `json:"index,omitempty"`
+		LedgerEntryType   EntryType
+		Flags             uint32
+		Account           types.Address
+		MPTokenIssuanceID types.Hash192
+		MPTAmount         json.RawMessage
+		LockedAmount      json.RawMessage `json:",omitempty"`
+		PreviousTxnID     types.Hash256
+		PreviousTxnLgrSeq uint32
+		OwnerNode         json.RawMessage
+	}
+	var h mpTokenHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*c = MPToken{
+		Index:             h.Index,
+		LedgerEntryType:   h.LedgerEntryType,
+		Flags:             h.Flags,
+		Account:           h.Account,
+		MPTokenIssuanceID: h.MPTokenIssuanceID,
+		PreviousTxnID:     h.PreviousTxnID,
+		PreviousTxnLgrSeq: h.PreviousTxnLgrSeq,
+	}
+	var err error
+	if c.MPTAmount, err = unmarshalUint64(h.MPTAmount, 10); err != nil {
+		return err
+	}
+	if c.LockedAmount, err = unmarshalUint64(h.LockedAmount, 10); err != nil {
+		return err
+	}
+	c.OwnerNode, err = unmarshalUint64(h.OwnerNode, 16)
+	return err
+}
+
 // SetLsfMPTLocked sets the lsfMPTLocked flag.
 func (c *MPToken) SetLsfMPTLocked() {
 	c.Flags |= lsfMPTLocked
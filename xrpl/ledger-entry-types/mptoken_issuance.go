@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	"encoding/json"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -68,6 +69,54 @@ func (*MPTokenIssuance) EntryType() EntryType {
 	return MPTokenIssuanceEntry
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for MPTokenIssuance.
+func (c *MPTokenIssuance) UnmarshalJSON(data []byte) error {
+	type mpTokenIssuanceHelper struct {
+		Index             types.Hash256 `json:"index,omitempty"`
+		LedgerEntryType   EntryType
+		Flags             uint32
+		Issuer            types.Address
+		AssetScale        uint8
+		MaximumAmount     json.RawMessage
+		OutstandingAmount json.RawMessage
+		TransferFee       uint16
+		MPTokenMetadata   string
+		OwnerNode         json.RawMessage
+		PreviousTxnID     types.Hash256
+		PreviousTxnLgrSeq uint32
+		Sequence          uint32
+		LockedAmount      json.RawMessage `json:",omitempty"`
+	}
+	var h mpTokenIssuanceHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*c = MPTokenIssuance{
+		Index:             h.Index,
+		LedgerEntryType:   h.LedgerEntryType,
+		Flags:             h.Flags,
+		Issuer:            h.Issuer,
+		AssetScale:        h.AssetScale,
+		TransferFee:       h.TransferFee,
+		MPTokenMetadata:   h.MPTokenMetadata,
+		PreviousTxnID:     h.PreviousTxnID,
+		PreviousTxnLgrSeq: h.PreviousTxnLgrSeq,
+		Sequence:          h.Sequence,
+	}
+	var err error
+	if c.MaximumAmount, err = unmarshalUint64(h.MaximumAmount, 10); err != nil {
+		return err
+	}
+	if c.OutstandingAmount, err = unmarshalUint64(h.OutstandingAmount, 10); err != nil {
+		return err
+	}
+	if c.LockedAmount, err = unmarshalUint64(h.LockedAmount, 10); err != nil {
+		return err
+	}
+	c.OwnerNode, err = unmarshalUint64(h.OwnerNode, 16)
+	return err
+}
+
 // SetLsfMPTLocked sets the lsfMPTLocked flag.
 func (c *MPTokenIssuance) SetLsfMPTLocked() {
 	c.Flags |= lsfMPTLocked
@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
@@ -303,3 +304,23 @@ func TestMPTokenIssuanceSerialization(t *testing.T) {
 		})
 	}
 }
+
+func TestMPTokenIssuance_UnmarshalJSON(t *testing.T) {
+	var issuance MPTokenIssuance
+	err := json.Unmarshal([]byte(`{
+	"LedgerEntryType": "MPTokenIssuance",
+	"Issuer": "rU6K7V3Po4snVhBBaU29sesqs2qTQJWDw1",
+	"AssetScale": 2,
+	"MaximumAmount": "9223372036854775807",
+	"OutstandingAmount": "100",
+	"LockedAmount": "5",
+	"OwnerNode": "0",
+	"Sequence": 1,
+	"TransferFee": 314
+}`), &issuance)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9223372036854775807), issuance.MaximumAmount)
+	require.Equal(t, uint64(100), issuance.OutstandingAmount)
+	require.Equal(t, uint64(5), issuance.LockedAmount)
+	require.Equal(t, uint16(314), issuance.TransferFee)
+}
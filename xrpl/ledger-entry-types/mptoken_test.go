@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
@@ -148,3 +149,41 @@ func TestMPTokenSerialization(t *testing.T) {
 		})
 	}
 }
+
+func TestMPToken_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		expected MPToken
+	}{
+		{
+			name: "pass - amounts as strings",
+			json: `{"LedgerEntryType": "MPToken", "Account": "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD", "MPTAmount": "1000000", "LockedAmount": "10", "OwnerNode": "1a"}`,
+			expected: MPToken{
+				LedgerEntryType: MPTokenEntry,
+				Account:         "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+				MPTAmount:       1000000,
+				LockedAmount:    10,
+				OwnerNode:       0x1a,
+			},
+		},
+		{
+			name: "pass - amounts as numbers",
+			json: `{"LedgerEntryType": "MPToken", "Account": "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD", "MPTAmount": 1000000, "OwnerNode": 1}`,
+			expected: MPToken{
+				LedgerEntryType: MPTokenEntry,
+				Account:         "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+				MPTAmount:       1000000,
+				OwnerNode:       1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mpToken MPToken
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &mpToken))
+			require.Equal(t, tt.expected, mpToken)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // NFTokenPage object represents a collection of NFTs owned by the same account.
 // An account can have multiple NFTokenPage entries, which form a doubly linked list.
@@ -59,3 +63,44 @@ type NFTokenPage struct {
 func (*NFTokenPage) EntryType() EntryType {
 	return NFTokenPageEntry
 }
+
+// UnmarshalJSON implements custom JSON unmarshalling for NFTokenPage.
+// Servers wrap every NFT of the page in an NFToken object, which is unwrapped.
+func (n *NFTokenPage) UnmarshalJSON(data []byte) error {
+	type nfTokenWrapper struct {
+		Wrapped    *types.NFToken `json:"NFToken"`
+		NFTokenID  types.NFTokenID
+		NFTokenURI types.NFTokenURI `json:"URI"`
+	}
+	type nfTokenPageHelper struct {
+		Index             types.Hash256 `json:"index,omitempty"`
+		LedgerEntryType   EntryType
+		Flags             uint32
+		NextPageMin       types.Hash256 `json:",omitempty"`
+		PreviousPageMin   types.Hash256 `json:",omitempty"`
+		PreviousTxnID     types.Hash256 `json:",omitempty"`
+		PreviousTxnLgrSeq uint32        `json:",omitempty"`
+		NFTokens          []nfTokenWrapper
+	}
+	var h nfTokenPageHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*n = NFTokenPage{
+		Index:             h.Index,
+		LedgerEntryType:   h.LedgerEntryType,
+		Flags:             h.Flags,
+		NextPageMin:       h.NextPageMin,
+		PreviousPageMin:   h.PreviousPageMin,
+		PreviousTxnID:     h.PreviousTxnID,
+		PreviousTxnLgrSeq: h.PreviousTxnLgrSeq,
+	}
+	for _, token := range h.NFTokens {
+		if token.Wrapped != nil {
+			n.NFTokens = append(n.NFTokens, *token.Wrapped)
+			continue
+		}
+		n.NFTokens = append(n.NFTokens, types.NFToken{NFTokenID: token.NFTokenID, NFTokenURI: token.NFTokenURI})
+	}
+	return nil
+}
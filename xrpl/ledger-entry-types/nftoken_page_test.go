@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
@@ -43,3 +44,21 @@ func TestNFTokenPage_EntryType(t *testing.T) {
 	s := &NFTokenPage{}
 	require.Equal(t, s.EntryType(), NFTokenPageEntry)
 }
+
+func TestNFTokenPage_UnmarshalJSON(t *testing.T) {
+	var page NFTokenPage
+	err := json.Unmarshal([]byte(`{
+	"LedgerEntryType": "NFTokenPage",
+	"NFTokens": [
+		{"NFToken": {"NFTokenID": "000B013A95F14B0044F78A264E41713C64B5F89242540EE208C3098E00000D65", "URI": "697066733A2F2F"}},
+		{"NFTokenID": "000B013A95F14B0044F78A264E41713C64B5F89242540EE208C3098E00000D66"}
+	],
+	"PreviousTxnLgrSeq": 42891441
+}`), &page)
+	require.NoError(t, err)
+	require.Equal(t, []types.NFToken{
+		{NFTokenID: "000B013A95F14B0044F78A264E41713C64B5F89242540EE208C3098E00000D65", NFTokenURI: "697066733A2F2F"},
+		{NFTokenID: "000B013A95F14B0044F78A264E41713C64B5F89242540EE208C3098E00000D66"},
+	}, page.NFTokens)
+	require.Equal(t, uint32(42891441), page.PreviousTxnLgrSeq)
+}
@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	"encoding/json"
 	"strconv"
 
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -69,6 +70,29 @@ func (mw *PriceDataWrapper) Flatten() map[string]any {
 	return nil
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for PriceData.
+// Servers send the AssetPrice as a hex string.
+func (priceData *PriceData) UnmarshalJSON(data []byte) error {
+	type priceDataHelper struct {
+		BaseAsset  string
+		QuoteAsset string
+		AssetPrice json.RawMessage `json:",omitempty"`
+		Scale      uint8           `json:",omitempty"`
+	}
+	var h priceDataHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*priceData = PriceData{
+		BaseAsset:  h.BaseAsset,
+		QuoteAsset: h.QuoteAsset,
+		Scale:      h.Scale,
+	}
+	var err error
+	priceData.AssetPrice, err = unmarshalUint64(h.AssetPrice, 16)
+	return err
+}
+
 // Flatten flattens the price data.
 func (priceData *PriceData) Flatten() map[string]any {
 	mapKeys := 2
@@ -157,3 +181,37 @@ type Oracle struct {
 func (*Oracle) EntryType() EntryType {
 	return OracleEntry
 }
+
+// UnmarshalJSON implements custom JSON unmarshalling for Oracle.
+func (o *Oracle) UnmarshalJSON(data []byte) error {
+	type oracleHelper struct {
+		Index             types.Hash256 `json:"index,omitempty"`
+		Owner             types.Address
+		Provider          string
+		PriceDataSeries   []PriceDataWrapper
+		LastUpdateTime    uint32
+		URI               string `json:",omitempty"`
+		AssetClass        string
+		OwnerNode         json.RawMessage
+		PreviousTxnID     string
+		PreviousTxnLgrSeq uint32
+	}
+	var h oracleHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*o = Oracle{
+		Index:             h.Index,
+		Owner:             h.Owner,
+		Provider:          h.Provider,
+		PriceDataSeries:   h.PriceDataSeries,
+		LastUpdateTime:    h.LastUpdateTime,
+		URI:               h.URI,
+		AssetClass:        h.AssetClass,
+		PreviousTxnID:     h.PreviousTxnID,
+		PreviousTxnLgrSeq: h.PreviousTxnLgrSeq,
+	}
+	var err error
+	o.OwnerNode, err = unmarshalUint64(h.OwnerNode, 16)
+	return err
+}
@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -183,3 +184,23 @@ func TestPriceData_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestOracle_UnmarshalJSON(t *testing.T) {
+	var oracle Oracle
+	err := json.Unmarshal([]byte(`{
+	"LedgerEntryType": "Oracle",
+	"Owner": "rNZ9m6AP9K7z3EVg6GhPMx36V4QmZKeWds",
+	"PriceDataSeries": [
+		{"PriceData": {"BaseAsset": "XRP", "QuoteAsset": "USD", "AssetPrice": "2e4", "Scale": 3}},
+		{"PriceData": {"BaseAsset": "XRP", "QuoteAsset": "EUR"}}
+	],
+	"LastUpdateTime": 1724871860,
+	"OwnerNode": "0"
+}`), &oracle)
+	assert.NoError(t, err)
+	assert.Len(t, oracle.PriceDataSeries, 2)
+	assert.Equal(t, uint64(0x2e4), oracle.PriceDataSeries[0].PriceData.AssetPrice)
+	assert.Equal(t, uint8(3), oracle.PriceDataSeries[0].PriceData.Scale)
+	assert.Zero(t, oracle.PriceDataSeries[1].PriceData.AssetPrice)
+	assert.Equal(t, uint32(1724871860), oracle.LastUpdateTime)
+}
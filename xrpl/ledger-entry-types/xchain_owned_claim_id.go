@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // XChainClaimProofSig holds the parameters of a proof signature for a cross-chain claim attestation.
 type XChainClaimProofSig struct {
@@ -18,6 +22,38 @@ type XChainClaimProofSig struct {
 	WasLockingChainSend uint8
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for XChainClaimProofSig.
+func (x *XChainClaimProofSig) UnmarshalJSON(data []byte) error {
+	type proofSigHelper struct {
+		Amount                   json.RawMessage
+		AttestationRewardAccount types.Address
+		AttestationSignerAccount types.Address
+		Destination              types.Address `json:",omitempty"`
+		PublicKey                string
+		WasLockingChainSend      uint8
+	}
+	var h proofSigHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainClaimProofSig{
+		AttestationRewardAccount: h.AttestationRewardAccount,
+		AttestationSignerAccount: h.AttestationSignerAccount,
+		Destination:              h.Destination,
+		PublicKey:                h.PublicKey,
+		WasLockingChainSend:      h.WasLockingChainSend,
+	}
+	if len(h.Amount) == 0 || string(h.Amount) == "null" {
+		return nil
+	}
+	amount, err := types.UnmarshalCurrencyAmount(h.Amount)
+	if err != nil {
+		return err
+	}
+	x.Amount = amount
+	return nil
+}
+
 // XChainClaimAttestation represents an attestation containing a proof signature from a witness server for a cross-chain claim.
 type XChainClaimAttestation struct {
 	// An attestation from one witness server.
@@ -93,7 +129,7 @@ type XChainOwnedClaimID struct {
 	// The total amount to pay the witness servers for their signatures. It must be at least the value of SignatureReward in the Bridge ledger object.
 	SignatureReward types.CurrencyAmount
 	// The door accounts and assets of the bridge this object correlates to.
-	XChainBridge types.XChainBridge
+	XChainBridge XChainBridge
 	// Attestations collected from the witness servers. This includes the parameters needed to recreate the message
 	// that was signed, including the amount, which chain (locking or issuing), optional destination, and reward account for that signature.
 	XChainClaimAttestations []XChainClaimAttestation
@@ -105,3 +141,37 @@ type XChainOwnedClaimID struct {
 func (*XChainOwnedClaimID) EntryType() EntryType {
 	return XChainOwnedClaimIDEntry
 }
+
+// UnmarshalJSON implements custom JSON unmarshalling for XChainOwnedClaimID.
+func (x *XChainOwnedClaimID) UnmarshalJSON(data []byte) error {
+	type claimIDHelper struct {
+		Account                 types.Address
+		Index                   types.Hash256 `json:"index,omitempty"`
+		OtherChainSource        types.Address
+		SignatureReward         json.RawMessage
+		XChainBridge            XChainBridge
+		XChainClaimAttestations []XChainClaimAttestation
+		XChainClaimID           string
+	}
+	var h claimIDHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainOwnedClaimID{
+		Account:                 h.Account,
+		Index:                   h.Index,
+		OtherChainSource:        h.OtherChainSource,
+		XChainBridge:            h.XChainBridge,
+		XChainClaimAttestations: h.XChainClaimAttestations,
+		XChainClaimID:           h.XChainClaimID,
+	}
+	if len(h.SignatureReward) == 0 || string(h.SignatureReward) == "null" {
+		return nil
+	}
+	reward, err := types.UnmarshalCurrencyAmount(h.SignatureReward)
+	if err != nil {
+		return err
+	}
+	x.SignatureReward = reward
+	return nil
+}
@@ -1,6 +1,8 @@
 package ledger
 
 import (
+	"encoding/json"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,3 +12,37 @@ func TestXChainOwnedClaimID_EntryType(t *testing.T) {
 	entry := &XChainOwnedClaimID{}
 	assert.Equal(t, XChainOwnedClaimIDEntry, entry.EntryType())
 }
+
+func TestXChainOwnedClaimID_UnmarshalJSON(t *testing.T) {
+	var claimID XChainOwnedClaimID
+	err := json.Unmarshal([]byte(`{
+	"Account": "rBW1U7J9mEhEdk6dMHEFUjqQ7HW7WpaEMi",
+	"LedgerEntryType": "XChainOwnedClaimID",
+	"OtherChainSource": "r9oXrvBX5aDoyMGkoYvzazxDhYoWFUjz8p",
+	"SignatureReward": "100",
+	"XChainBridge": {
+		"IssuingChainDoor": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"IssuingChainIssue": {"currency": "XRP"},
+		"LockingChainDoor": "rMAXACCrp3Y8PpswXcg3bKggHX76V3F8M4",
+		"LockingChainIssue": {"currency": "XRP"}
+	},
+	"XChainClaimAttestations": [
+		{
+			"XChainClaimProofSig": {
+				"Amount": "1000000",
+				"AttestationRewardAccount": "rfgjrgEJGDxfUY2U8VEDs7BnB1jiH3ofu6",
+				"AttestationSignerAccount": "rfsxNxZ6xB1nTPhTMwQajNnkCxWG8B714n",
+				"PublicKey": "025CA526EF20567A50FEC504589F949E0E3401C13EF76DD5FD1CC2850FA485BD7B",
+				"WasLockingChainSend": 1
+			}
+		}
+	],
+	"XChainClaimID": "b5"
+}`), &claimID)
+	assert.NoError(t, err)
+	assert.Equal(t, types.XRPCurrencyAmount(100), claimID.SignatureReward)
+	assert.Equal(t, Asset{Currency: "XRP"}, claimID.XChainBridge.LockingChainIssue)
+	assert.Len(t, claimID.XChainClaimAttestations, 1)
+	assert.Equal(t, types.XRPCurrencyAmount(1000000), claimID.XChainClaimAttestations[0].XChainClaimProofSig.Amount)
+	assert.Equal(t, uint8(1), claimID.XChainClaimAttestations[0].XChainClaimProofSig.WasLockingChainSend)
+}
@@ -5,4 +5,6 @@ import "errors"
 var (
 	// ErrNoAccountID is returned when no account ID is specified in a request.
 	ErrNoAccountID = errors.New("no account ID specified")
+	// ErrInvalidNoRippleCheckRole is returned when the role of a noripple_check request isn't gateway or user.
+	ErrInvalidNoRippleCheckRole = errors.New("role must be gateway or user")
 )
@@ -7,6 +7,7 @@ import (
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNoRippleCheckRequest(t *testing.T) {
@@ -67,3 +68,36 @@ func TestNoRippleCheckResponse(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestNoRippleCheckRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         NoRippleCheckRequest
+		expectedErr error
+	}{
+		{
+			name: "pass - gateway",
+			req:  NoRippleCheckRequest{Account: "r9cZA1mLK5R5Am25ArfXF7tRp1PeperEvH", Role: "gateway"},
+		},
+		{
+			name: "pass - user",
+			req:  NoRippleCheckRequest{Account: "r9cZA1mLK5R5Am25ArfXF7tRp1PeperEvH", Role: "user"},
+		},
+		{
+			name:        "fail - no account",
+			req:         NoRippleCheckRequest{Role: "user"},
+			expectedErr: ErrNoAccountID,
+		},
+		{
+			name:        "fail - invalid role",
+			req:         NoRippleCheckRequest{Account: "r9cZA1mLK5R5Am25ArfXF7tRp1PeperEvH", Role: "issuer"},
+			expectedErr: ErrInvalidNoRippleCheckRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.expectedErr)
+		})
+	}
+}
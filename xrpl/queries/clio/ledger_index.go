@@ -0,0 +1,53 @@
+package clio
+
+import (
+	"time"
+
+	cliotypes "github.com/Peersyst/xrpl-go/xrpl/queries/clio/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// LedgerIndexRequest retrieves the most recent validated ledger closed at or before a date,
+// or the latest validated ledger if Date is empty.
+type LedgerIndexRequest struct {
+	common.BaseRequest
+	// Date is an ISO 8601 timestamp, such as 2024-06-20T09:00:42Z.
+	Date string `json:"date,omitempty"`
+}
+
+// Method returns the JSON-RPC method name for LedgerIndexRequest.
+func (*LedgerIndexRequest) Method() string {
+	return "ledger_index"
+}
+
+// APIVersion returns the Rippled API version for LedgerIndexRequest.
+func (*LedgerIndexRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate checks the LedgerIndexRequest parameters for validity.
+func (r *LedgerIndexRequest) Validate() error {
+	if r.Date == "" {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, r.Date); err != nil {
+		return cliotypes.ErrInvalidLedgerIndexDate
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// LedgerIndexResponse is the response returned by the ledger_index method, identifying the matching ledger.
+type LedgerIndexResponse struct {
+	LedgerIndex common.LedgerIndex `json:"ledger_index"`
+	LedgerHash  common.LedgerHash  `json:"ledger_hash"`
+	Closed      string             `json:"closed"`
+}
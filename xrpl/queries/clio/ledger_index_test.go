@@ -0,0 +1,67 @@
+package clio
+
+import (
+	"testing"
+
+	cliotypes "github.com/Peersyst/xrpl-go/xrpl/queries/clio/types"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerIndexRequest(t *testing.T) {
+	s := LedgerIndexRequest{
+		Date: "2024-06-20T09:00:42Z",
+	}
+
+	j := `{
+	"date": "2024-06-20T09:00:42Z"
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLedgerIndexResponse(t *testing.T) {
+	s := LedgerIndexResponse{
+		LedgerIndex: 3853789,
+		LedgerHash:  "B49D1F39F11E7BE1FA4F1E1C30B8C8B0C4AD9B7E0B1D06D8F3E8E0AF5D1A6C5E",
+		Closed:      "2024-06-20T09:00:41Z",
+	}
+	j := `{
+	"ledger_index": 3853789,
+	"ledger_hash": "B49D1F39F11E7BE1FA4F1E1C30B8C8B0C4AD9B7E0B1D06D8F3E8E0AF5D1A6C5E",
+	"closed": "2024-06-20T09:00:41Z"
+}`
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLedgerIndexRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         LedgerIndexRequest
+		expectedErr error
+	}{
+		{
+			name: "pass - no date",
+			req:  LedgerIndexRequest{},
+		},
+		{
+			name: "pass - date",
+			req:  LedgerIndexRequest{Date: "2024-06-20T09:00:42.000Z"},
+		},
+		{
+			name:        "fail - invalid date",
+			req:         LedgerIndexRequest{Date: "20/06/2024"},
+			expectedErr: cliotypes.ErrInvalidLedgerIndexDate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package clio
+
+import (
+	cliotypes "github.com/Peersyst/xrpl-go/xrpl/queries/clio/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// MPTHoldersRequest retrieves the accounts holding an MPT issuance and their balances.
+type MPTHoldersRequest struct {
+	common.BaseRequest
+	MPTIssuanceID string                 `json:"mpt_issuance_id"`
+	LedgerHash    common.LedgerHash      `json:"ledger_hash,omitempty"`
+	LedgerIndex   common.LedgerSpecifier `json:"ledger_index,omitempty"`
+	Limit         uint                   `json:"limit,omitempty"`
+	Marker        any                    `json:"marker,omitempty"`
+}
+
+// Method returns the JSON-RPC method name for MPTHoldersRequest.
+func (*MPTHoldersRequest) Method() string {
+	return "mpt_holders"
+}
+
+// APIVersion returns the Rippled API version for MPTHoldersRequest.
+func (*MPTHoldersRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate checks the MPTHoldersRequest parameters for validity.
+func (r *MPTHoldersRequest) Validate() error {
+	if r.MPTIssuanceID == "" {
+		return cliotypes.ErrNoMPTIssuanceID
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// MPTHoldersResponse is the response returned by the mpt_holders method, containing the holders of the issuance.
+type MPTHoldersResponse struct {
+	MPTIssuanceID string                `json:"mpt_issuance_id"`
+	MPTokens      []cliotypes.MPTHolder `json:"mptokens"`
+	Limit         uint                  `json:"limit,omitempty"`
+	Marker        any                   `json:"marker,omitempty"`
+	LedgerIndex   common.LedgerIndex    `json:"ledger_index,omitempty"`
+	Validated     bool                  `json:"validated,omitempty"`
+}
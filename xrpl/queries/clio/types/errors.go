@@ -0,0 +1,11 @@
+//revive:disable:var-naming
+package types
+
+import "errors"
+
+var (
+	// ErrInvalidLedgerIndexDate is returned when the date of a ledger_index request isn't an ISO 8601 timestamp.
+	ErrInvalidLedgerIndexDate = errors.New("date must be an ISO 8601 timestamp")
+	// ErrNoMPTIssuanceID is returned when the MPT issuance ID of an mpt_holders request is empty.
+	ErrNoMPTIssuanceID = errors.New("no MPT issuance ID specified")
+)
@@ -0,0 +1,13 @@
+//revive:disable:var-naming
+package types
+
+import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+// MPTHolder is a struct that represents the MPToken of an account holding an MPT issuance.
+type MPTHolder struct {
+	Account      types.Address `json:"account"`
+	Flags        uint32        `json:"flags"`
+	MPTAmount    string        `json:"mpt_amount"`
+	LockedAmount string        `json:"locked_amount,omitempty"`
+	MPTokenIndex string        `json:"mptoken_index"`
+}
@@ -0,0 +1,14 @@
+package ledger
+
+import "errors"
+
+var (
+	// ErrNoEntrySelector is returned when no ledger entry is selected in the EntryRequest.
+	ErrNoEntrySelector = errors.New("no ledger entry selector defined")
+	// ErrMultipleEntrySelectors is returned when more than one ledger entry is selected in the EntryRequest.
+	ErrMultipleEntrySelectors = errors.New("only one ledger entry selector can be defined")
+	// ErrMissingEntrySelectorField is returned when a required field of the ledger entry selector is empty.
+	ErrMissingEntrySelectorField = errors.New("missing ledger entry selector field")
+	// ErrInvalidEntrySelectorAddress is returned when an address of the ledger entry selector is invalid.
+	ErrInvalidEntrySelectorAddress = errors.New("invalid ledger entry selector address")
+)
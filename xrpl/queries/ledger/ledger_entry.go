@@ -1,22 +1,47 @@
 package ledger
 
 import (
+	"fmt"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
 // ############################################################################
 // Request
 // ############################################################################
 
-// EntryRequest retrieves a specific ledger entry by its index.
+// EntryRequest retrieves a specific ledger entry, either by its index or with the selector of its type.
+// Exactly one of Index and the selectors must be set. The selectors given as a string also accept the
+// index of the entry. A bridge is selected with both BridgeAccount and Bridge.
 type EntryRequest struct {
 	common.BaseRequest
-	Index       string                 `json:"index"`
-	LedgerIndex common.LedgerSpecifier `json:"ledger_index,omitempty"`
-	LedgerHash  common.LedgerHash      `json:"ledger_hash,omitempty"`
-	Binary      bool                   `json:"binary,omitempty"`
+	Index              string                                  `json:"index,omitempty"`
+	AccountRoot        types.Address                           `json:"account_root,omitempty"`
+	RippleState        *ledgertypes.RippleStateSelector        `json:"ripple_state,omitempty"`
+	Offer              *ledgertypes.OfferSelector              `json:"offer,omitempty"`
+	Escrow             *ledgertypes.EscrowSelector             `json:"escrow,omitempty"`
+	PaymentChannel     string                                  `json:"payment_channel,omitempty"`
+	Check              string                                  `json:"check,omitempty"`
+	Ticket             *ledgertypes.TicketSelector             `json:"ticket,omitempty"`
+	NFTPage            string                                  `json:"nft_page,omitempty"`
+	AMM                *ledgertypes.AMMSelector                `json:"amm,omitempty"`
+	DID                types.Address                           `json:"did,omitempty"`
+	Oracle             *ledgertypes.OracleSelector             `json:"oracle,omitempty"`
+	Credential         *ledgertypes.CredentialSelector         `json:"credential,omitempty"`
+	MPTIssuance        string                                  `json:"mpt_issuance,omitempty"`
+	MPToken            *ledgertypes.MPTokenSelector            `json:"mptoken,omitempty"`
+	PermissionedDomain *ledgertypes.PermissionedDomainSelector `json:"permissioned_domain,omitempty"`
+	BridgeAccount      types.Address                           `json:"bridge_account,omitempty"`
+	Bridge             *ledger.XChainBridge                    `json:"bridge,omitempty"`
+	XChainOwnedClaimID *ledgertypes.XChainOwnedClaimIDSelector `json:"xchain_owned_claim_id,omitempty"`
+	LedgerIndex        common.LedgerSpecifier                  `json:"ledger_index,omitempty"`
+	LedgerHash         common.LedgerHash                       `json:"ledger_hash,omitempty"`
+	Binary             bool                                    `json:"binary,omitempty"`
 }
 
 // Method returns the JSON-RPC method name for EntryRequest.
@@ -29,11 +54,121 @@ func (*EntryRequest) APIVersion() int {
 	return version.RippledAPIV2
 }
 
-// Validate checks the EntryRequest fields for validity.
-func (*EntryRequest) Validate() error {
+// Validate checks that exactly one ledger entry is selected in the EntryRequest, and that its selector
+// has the required fields and valid addresses.
+func (r *EntryRequest) Validate() error {
+	selected := 0
+	for _, set := range []bool{
+		r.Index != "", r.AccountRoot != "", r.RippleState != nil, r.Offer != nil, r.Escrow != nil,
+		r.PaymentChannel != "", r.Check != "", r.Ticket != nil, r.NFTPage != "", r.AMM != nil,
+		r.DID != "", r.Oracle != nil, r.Credential != nil, r.MPTIssuance != "", r.MPToken != nil,
+		r.PermissionedDomain != nil, r.Bridge != nil || r.BridgeAccount != "", r.XChainOwnedClaimID != nil,
+	} {
+		if set {
+			selected++
+		}
+	}
+	if selected == 0 {
+		return ErrNoEntrySelector
+	}
+	if selected > 1 {
+		return ErrMultipleEntrySelectors
+	}
+
+	switch {
+	case r.AccountRoot != "":
+		return validateSelectorAddresses("account_root", r.AccountRoot)
+	case r.RippleState != nil:
+		if r.RippleState.Currency == "" {
+			return fmt.Errorf("%w: ripple_state.currency", ErrMissingEntrySelectorField)
+		}
+		return validateSelectorAddresses("ripple_state.accounts", r.RippleState.Accounts[:]...)
+	case r.Offer != nil:
+		return validateSelectorAddresses("offer.account", r.Offer.Account)
+	case r.Escrow != nil:
+		return validateSelectorAddresses("escrow.owner", r.Escrow.Owner)
+	case r.Ticket != nil:
+		return validateSelectorAddresses("ticket.account", r.Ticket.Account)
+	case r.AMM != nil:
+		if err := validateSelectorAsset("amm.asset", r.AMM.Asset); err != nil {
+			return err
+		}
+		return validateSelectorAsset("amm.asset2", r.AMM.Asset2)
+	case r.DID != "":
+		return validateSelectorAddresses("did", r.DID)
+	case r.Oracle != nil:
+		return validateSelectorAddresses("oracle.account", r.Oracle.Account)
+	case r.Credential != nil:
+		if r.Credential.CredentialType == "" {
+			return fmt.Errorf("%w: credential.credential_type", ErrMissingEntrySelectorField)
+		}
+		if err := validateSelectorAddresses("credential.subject", r.Credential.Subject); err != nil {
+			return err
+		}
+		return validateSelectorAddresses("credential.issuer", r.Credential.Issuer)
+	case r.MPToken != nil:
+		if r.MPToken.MPTIssuanceID == "" {
+			return fmt.Errorf("%w: mptoken.mpt_issuance_id", ErrMissingEntrySelectorField)
+		}
+		return validateSelectorAddresses("mptoken.account", r.MPToken.Account)
+	case r.PermissionedDomain != nil:
+		return validateSelectorAddresses("permissioned_domain.account", r.PermissionedDomain.Account)
+	case r.Bridge != nil || r.BridgeAccount != "":
+		if r.Bridge == nil {
+			return fmt.Errorf("%w: bridge", ErrMissingEntrySelectorField)
+		}
+		if err := validateSelectorAddresses("bridge_account", r.BridgeAccount); err != nil {
+			return err
+		}
+		return validateSelectorBridge("bridge", *r.Bridge)
+	case r.XChainOwnedClaimID != nil:
+		return validateSelectorBridge("xchain_owned_claim_id", ledger.XChainBridge{
+			LockingChainDoor:  r.XChainOwnedClaimID.LockingChainDoor,
+			LockingChainIssue: r.XChainOwnedClaimID.LockingChainIssue,
+			IssuingChainDoor:  r.XChainOwnedClaimID.IssuingChainDoor,
+			IssuingChainIssue: r.XChainOwnedClaimID.IssuingChainIssue,
+		})
+	}
+	return nil
+}
+
+// validateSelectorAddresses checks that the addresses of the selector field are set and valid.
+func validateSelectorAddresses(field string, addresses ...types.Address) error {
+	for _, address := range addresses {
+		if address == "" {
+			return fmt.Errorf("%w: %s", ErrMissingEntrySelectorField, field)
+		}
+		if !addresscodec.IsValidAddress(address.String()) {
+			return fmt.Errorf("%w: %s", ErrInvalidEntrySelectorAddress, field)
+		}
+	}
 	return nil
 }
 
+// validateSelectorAsset checks that the asset of the selector field has a currency, and an issuer
+// unless it's XRP.
+func validateSelectorAsset(field string, asset ledger.Asset) error {
+	if asset.Currency == "" {
+		return fmt.Errorf("%w: %s.currency", ErrMissingEntrySelectorField, field)
+	}
+	if asset.Currency == "XRP" {
+		return nil
+	}
+	return validateSelectorAddresses(field+".issuer", asset.Issuer)
+}
+
+// validateSelectorBridge checks that the bridge of the selector field has valid door accounts and
+// valid issues.
+func validateSelectorBridge(field string, bridge ledger.XChainBridge) error {
+	if err := validateSelectorAsset(field+".LockingChainIssue", bridge.LockingChainIssue); err != nil {
+		return err
+	}
+	if err := validateSelectorAsset(field+".IssuingChainIssue", bridge.IssuingChainIssue); err != nil {
+		return err
+	}
+	return validateSelectorAddresses(field+" door", bridge.LockingChainDoor, bridge.IssuingChainDoor)
+}
+
 // ############################################################################
 // Response
 // ############################################################################
@@ -0,0 +1,185 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryRequest(t *testing.T) {
+	s := EntryRequest{
+		RippleState: &ledgertypes.RippleStateSelector{
+			Accounts: [2]types.Address{"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+			Currency: "USD",
+		},
+		LedgerIndex: common.Validated,
+	}
+	j := `{
+	"ripple_state": {
+		"accounts": [
+			"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+		],
+		"currency": "USD"
+	},
+	"ledger_index": "validated"
+}`
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEntryRequest_XChainOwnedClaimID(t *testing.T) {
+	s := EntryRequest{
+		XChainOwnedClaimID: &ledgertypes.XChainOwnedClaimIDSelector{
+			LockingChainDoor:   "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			LockingChainIssue:  ledger.Asset{Currency: "XRP"},
+			IssuingChainDoor:   "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			IssuingChainIssue:  ledger.Asset{Currency: "XRP"},
+			XChainOwnedClaimID: 4,
+		},
+	}
+	j := `{
+	"xchain_owned_claim_id": {
+		"LockingChainDoor": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"LockingChainIssue": {
+			"currency": "XRP"
+		},
+		"IssuingChainDoor": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"IssuingChainIssue": {
+			"currency": "XRP"
+		},
+		"xchain_owned_claim_id": 4
+	}
+}`
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEntryRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         EntryRequest
+		expectedErr error
+	}{
+		{
+			name: "pass - index",
+			req:  EntryRequest{Index: "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8"},
+		},
+		{
+			name: "pass - account root",
+			req:  EntryRequest{AccountRoot: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"},
+		},
+		{
+			name: "pass - amm with XRP",
+			req: EntryRequest{AMM: &ledgertypes.AMMSelector{
+				Asset:  ledger.Asset{Currency: "XRP"},
+				Asset2: ledger.Asset{Currency: "USD", Issuer: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"},
+			}},
+		},
+		{
+			name: "pass - bridge",
+			req: EntryRequest{
+				BridgeAccount: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				Bridge: &ledger.XChainBridge{
+					LockingChainDoor:  "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+					LockingChainIssue: ledger.Asset{Currency: "XRP"},
+					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					IssuingChainIssue: ledger.Asset{Currency: "XRP"},
+				},
+			},
+		},
+		{
+			name:        "fail - no selector",
+			req:         EntryRequest{LedgerIndex: common.Validated},
+			expectedErr: ErrNoEntrySelector,
+		},
+		{
+			name:        "fail - several selectors",
+			req:         EntryRequest{Check: "49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0", DID: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"},
+			expectedErr: ErrMultipleEntrySelectors,
+		},
+		{
+			name:        "fail - invalid account root",
+			req:         EntryRequest{AccountRoot: "rInvalid"},
+			expectedErr: ErrInvalidEntrySelectorAddress,
+		},
+		{
+			name: "fail - ripple state without currency",
+			req: EntryRequest{RippleState: &ledgertypes.RippleStateSelector{
+				Accounts: [2]types.Address{"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+			}},
+			expectedErr: ErrMissingEntrySelectorField,
+		},
+		{
+			name: "fail - ripple state with a single account",
+			req: EntryRequest{RippleState: &ledgertypes.RippleStateSelector{
+				Accounts: [2]types.Address{"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"},
+				Currency: "USD",
+			}},
+			expectedErr: ErrMissingEntrySelectorField,
+		},
+		{
+			name: "fail - amm asset without issuer",
+			req: EntryRequest{AMM: &ledgertypes.AMMSelector{
+				Asset:  ledger.Asset{Currency: "XRP"},
+				Asset2: ledger.Asset{Currency: "USD"},
+			}},
+			expectedErr: ErrMissingEntrySelectorField,
+		},
+		{
+			name: "fail - credential without type",
+			req: EntryRequest{Credential: &ledgertypes.CredentialSelector{
+				Subject: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				Issuer:  "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			}},
+			expectedErr: ErrMissingEntrySelectorField,
+		},
+		{
+			name:        "fail - mptoken without issuance",
+			req:         EntryRequest{MPToken: &ledgertypes.MPTokenSelector{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"}},
+			expectedErr: ErrMissingEntrySelectorField,
+		},
+		{
+			name:        "fail - bridge account without bridge",
+			req:         EntryRequest{BridgeAccount: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"},
+			expectedErr: ErrMissingEntrySelectorField,
+		},
+		{
+			name: "fail - claim ID with an invalid door",
+			req: EntryRequest{XChainOwnedClaimID: &ledgertypes.XChainOwnedClaimIDSelector{
+				LockingChainDoor:   "rInvalid",
+				LockingChainIssue:  ledger.Asset{Currency: "XRP"},
+				IssuingChainDoor:   "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				IssuingChainIssue:  ledger.Asset{Currency: "XRP"},
+				XChainOwnedClaimID: 4,
+			}},
+			expectedErr: ErrInvalidEntrySelectorAddress,
+		},
+		{
+			name: "fail - bridge issue without issuer",
+			req: EntryRequest{
+				BridgeAccount: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				Bridge: &ledger.XChainBridge{
+					LockingChainDoor:  "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+					LockingChainIssue: ledger.Asset{Currency: "USD"},
+					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					IssuingChainIssue: ledger.Asset{Currency: "USD", Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+				},
+			},
+			expectedErr: ErrMissingEntrySelectorField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// RippleStateSelector selects the trust line between two accounts for a currency.
+type RippleStateSelector struct {
+	Accounts [2]types.Address `json:"accounts"`
+	Currency string           `json:"currency"`
+}
+
+// OfferSelector selects the offer created by an account with an OfferCreate transaction of the given Sequence.
+type OfferSelector struct {
+	Account types.Address `json:"account"`
+	Seq     uint32        `json:"seq"`
+}
+
+// EscrowSelector selects the escrow created by an account with an EscrowCreate transaction of the given Sequence.
+type EscrowSelector struct {
+	Owner types.Address `json:"owner"`
+	Seq   uint32        `json:"seq"`
+}
+
+// TicketSelector selects the ticket of an account with the given TicketSequence.
+type TicketSelector struct {
+	Account   types.Address `json:"account"`
+	TicketSeq uint32        `json:"ticket_seq"`
+}
+
+// AMMSelector selects the AMM of an asset pair, in any order.
+type AMMSelector struct {
+	Asset  ledger.Asset `json:"asset"`
+	Asset2 ledger.Asset `json:"asset2"`
+}
+
+// OracleSelector selects the price oracle of an account with the given document ID.
+type OracleSelector struct {
+	Account          types.Address `json:"account"`
+	OracleDocumentID uint32        `json:"oracle_document_id"`
+}
+
+// CredentialSelector selects the credential of a type issued by an account to a subject.
+// CredentialType is hex-encoded.
+type CredentialSelector struct {
+	Subject        types.Address `json:"subject"`
+	Issuer         types.Address `json:"issuer"`
+	CredentialType string        `json:"credential_type"`
+}
+
+// MPTokenSelector selects the MPToken held by an account for an MPT issuance.
+type MPTokenSelector struct {
+	MPTIssuanceID string        `json:"mpt_issuance_id"`
+	Account       types.Address `json:"account"`
+}
+
+// PermissionedDomainSelector selects the permissioned domain created by an account with a
+// PermissionedDomainSet transaction of the given Sequence.
+type PermissionedDomainSelector struct {
+	Account types.Address `json:"account"`
+	Seq     uint32        `json:"seq"`
+}
+
+// XChainOwnedClaimIDSelector selects the cross-chain claim ID of a bridge. The bridge fields sit
+// next to the claim ID, as the server expects them.
+type XChainOwnedClaimIDSelector struct {
+	LockingChainDoor   types.Address `json:"LockingChainDoor"`
+	LockingChainIssue  ledger.Asset  `json:"LockingChainIssue"`
+	IssuingChainDoor   types.Address `json:"IssuingChainDoor"`
+	IssuingChainIssue  ledger.Asset  `json:"IssuingChainIssue"`
+	XChainOwnedClaimID uint64        `json:"xchain_owned_claim_id"`
+}
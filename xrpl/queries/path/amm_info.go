@@ -0,0 +1,62 @@
+package path
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// AMMInfoRequest retrieves information about an AMM instance, selected either by its asset pair
+// or by its AMM account.
+type AMMInfoRequest struct {
+	common.BaseRequest
+	Asset       *ledger.Asset          `json:"asset,omitempty"`
+	Asset2      *ledger.Asset          `json:"asset2,omitempty"`
+	AMMAccount  types.Address          `json:"amm_account,omitempty"`
+	Account     types.Address          `json:"account,omitempty"`
+	LedgerHash  common.LedgerHash      `json:"ledger_hash,omitempty"`
+	LedgerIndex common.LedgerSpecifier `json:"ledger_index,omitempty"`
+}
+
+// Method returns the JSON-RPC method name for the AMMInfoRequest.
+func (*AMMInfoRequest) Method() string {
+	return "amm_info"
+}
+
+// APIVersion returns the supported API version for the AMMInfoRequest.
+func (*AMMInfoRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate checks that the AMMInfoRequest selects the AMM either by both assets of its pair or by its account.
+func (r *AMMInfoRequest) Validate() error {
+	hasPair := r.Asset != nil || r.Asset2 != nil
+	switch {
+	case !hasPair && r.AMMAccount == "":
+		return pathtypes.ErrNoAMMSelector
+	case hasPair && r.AMMAccount != "":
+		return pathtypes.ErrAMMAssetPairAndAccount
+	case hasPair && (r.Asset == nil || r.Asset2 == nil):
+		return pathtypes.ErrIncompleteAMMAssetPair
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// AMMInfoResponse represents the expected response from the amm_info method.
+type AMMInfoResponse struct {
+	AMM                pathtypes.AMMPool  `json:"amm"`
+	LedgerCurrentIndex common.LedgerIndex `json:"ledger_current_index,omitempty"`
+	LedgerHash         common.LedgerHash  `json:"ledger_hash,omitempty"`
+	LedgerIndex        common.LedgerIndex `json:"ledger_index,omitempty"`
+	Validated          bool               `json:"validated,omitempty"`
+}
@@ -0,0 +1,58 @@
+//revive:disable:var-naming
+package types
+
+import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+// AMMPool describes an AMM instance as returned by amm_info: the assets in its pool, its LP tokens,
+// trading fee, auction slot and votes.
+type AMMPool struct {
+	// The address of the special account that holds the assets of the AMM.
+	Account types.Address `json:"account"`
+	// The total amount of one asset in the pool, in XRP drops or as an issued currency amount.
+	Amount any `json:"amount"`
+	// The total amount of the other asset in the pool, in XRP drops or as an issued currency amount.
+	Amount2 any `json:"amount2"`
+	// Whether the first asset is frozen. Omitted for XRP.
+	AssetFrozen bool `json:"asset_frozen,omitempty"`
+	// Whether the second asset is frozen. Omitted for XRP.
+	Asset2Frozen bool `json:"asset2_frozen,omitempty"`
+	// The current owner of the auction slot, if any.
+	AuctionSlot *AMMAuctionSlot `json:"auction_slot,omitempty"`
+	// The total outstanding LP tokens of the AMM, or the LP tokens held by the account of the request if it was set.
+	LPToken types.IssuedCurrencyAmount `json:"lp_token"`
+	// The fee charged for trades against the AMM, in units of 1/100,000.
+	TradingFee uint16 `json:"trading_fee"`
+	// The current votes on the trading fee of the AMM.
+	VoteSlots []AMMVoteSlot `json:"vote_slots,omitempty"`
+}
+
+// AMMAuctionSlot is the auction slot of an AMM, which lets its owner trade at a discounted fee.
+type AMMAuctionSlot struct {
+	// The current owner of the auction slot.
+	Account types.Address `json:"account"`
+	// Additional accounts authorized to trade at the discounted fee.
+	AuthAccounts []AMMAuthAccount `json:"auth_accounts,omitempty"`
+	// The trading fee charged to the owner of the slot, in units of 1/100,000.
+	DiscountedFee uint32 `json:"discounted_fee"`
+	// The time when the slot expires, in ISO 8601 format.
+	Expiration string `json:"expiration"`
+	// The amount of LP tokens the owner paid for the slot.
+	Price types.IssuedCurrencyAmount `json:"price"`
+	// The current 72-minute interval of the slot, from 0 to 19.
+	TimeInterval uint32 `json:"time_interval"`
+}
+
+// AMMAuthAccount is an account authorized to trade at the discounted fee of an auction slot.
+type AMMAuthAccount struct {
+	Account types.Address `json:"account"`
+}
+
+// AMMVoteSlot is the vote of a liquidity provider on the trading fee of an AMM.
+type AMMVoteSlot struct {
+	// The account that cast the vote.
+	Account types.Address `json:"account"`
+	// The trading fee proposed by the vote, in units of 1/100,000.
+	TradingFee uint16 `json:"trading_fee"`
+	// The weight of the vote, in units of 1/100,000, based on the LP tokens held by the account.
+	VoteWeight uint32 `json:"vote_weight"`
+}
@@ -0,0 +1,13 @@
+//revive:disable:var-naming
+package types
+
+import "errors"
+
+var (
+	// ErrNoAMMSelector is returned when neither the asset pair nor the AMM account is specified in an amm_info request.
+	ErrNoAMMSelector = errors.New("no asset pair or AMM account specified")
+	// ErrAMMAssetPairAndAccount is returned when both the asset pair and the AMM account are specified in an amm_info request.
+	ErrAMMAssetPairAndAccount = errors.New("only one of the asset pair or the AMM account can be specified")
+	// ErrIncompleteAMMAssetPair is returned when only one asset of the pair is specified in an amm_info request.
+	ErrIncompleteAMMAssetPair = errors.New("both assets of the pair must be specified")
+)
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// AMMInfoRequest is the request type for the amm_info command.
+// It retrieves information about an AMM instance, selected either by its asset pair or by its AMM account.
+type AMMInfoRequest struct {
+	common.BaseRequest
+	Asset       *ledger.Asset          `json:"asset,omitempty"`
+	Asset2      *ledger.Asset          `json:"asset2,omitempty"`
+	AMMAccount  types.Address          `json:"amm_account,omitempty"`
+	Account     types.Address          `json:"account,omitempty"`
+	LedgerHash  common.LedgerHash      `json:"ledger_hash,omitempty"`
+	LedgerIndex common.LedgerSpecifier `json:"ledger_index,omitempty"`
+}
+
+// Method returns the JSON-RPC method name for the AMMInfoRequest.
+func (*AMMInfoRequest) Method() string {
+	return "amm_info"
+}
+
+// APIVersion returns the API version required by the AMMInfoRequest.
+func (*AMMInfoRequest) APIVersion() int {
+	return version.RippledAPIV1
+}
+
+// Validate verifies that the AMMInfoRequest selects the AMM either by both assets of its pair or by its account.
+func (r *AMMInfoRequest) Validate() error {
+	hasPair := r.Asset != nil || r.Asset2 != nil
+	switch {
+	case !hasPair && r.AMMAccount == "":
+		return pathtypes.ErrNoAMMSelector
+	case hasPair && r.AMMAccount != "":
+		return pathtypes.ErrAMMAssetPairAndAccount
+	case hasPair && (r.Asset == nil || r.Asset2 == nil):
+		return pathtypes.ErrIncompleteAMMAssetPair
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// AMMInfoResponse is the response type returned by the amm_info command.
+// It describes the pool, LP tokens, trading fee, auction slot and votes of the AMM.
+type AMMInfoResponse struct {
+	AMM                pathtypes.AMMPool  `json:"amm"`
+	LedgerCurrentIndex common.LedgerIndex `json:"ledger_current_index,omitempty"`
+	LedgerHash         common.LedgerHash  `json:"ledger_hash,omitempty"`
+	LedgerIndex        common.LedgerIndex `json:"ledger_index,omitempty"`
+	Validated          bool               `json:"validated,omitempty"`
+}
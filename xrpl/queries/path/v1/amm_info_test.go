@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+func TestAMMInfoRequest(t *testing.T) {
+	s := AMMInfoRequest{
+		Asset:       &ledger.Asset{Currency: "XRP"},
+		Asset2:      &ledger.Asset{Currency: "TST", Issuer: "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"},
+		LedgerIndex: common.Validated,
+	}
+
+	j := `{
+	"asset": {
+		"currency": "XRP"
+	},
+	"asset2": {
+		"currency": "TST",
+		"issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"
+	},
+	"ledger_index": "validated"
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAMMInfoResponse(t *testing.T) {
+	s := AMMInfoResponse{
+		AMM: pathtypes.AMMPool{
+			Account: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+			Amount:  "296890496",
+			Amount2: map[string]any{
+				"currency": "TST",
+				"issuer":   "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd",
+				"value":    "25.81656470648473",
+			},
+			Asset2Frozen: false,
+			AuctionSlot: &pathtypes.AMMAuctionSlot{
+				Account:       "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+				AuthAccounts:  []pathtypes.AMMAuthAccount{{Account: "r9DKyNWtjXq1a1RFvtzDKvZKWaPn63rS23"}},
+				DiscountedFee: 60,
+				Expiration:    "2023-Jan-26 00:28:40.000000000 UTC",
+				Price: types.IssuedCurrencyAmount{
+					Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+					Issuer:   "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+					Value:    "0",
+				},
+				TimeInterval: 0,
+			},
+			LPToken: types.IssuedCurrencyAmount{
+				Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+				Issuer:   "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+				Value:    "87533.41976112682",
+			},
+			TradingFee: 600,
+			VoteSlots: []pathtypes.AMMVoteSlot{
+				{Account: "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm", TradingFee: 600, VoteWeight: 9684},
+			},
+		},
+		LedgerHash:  "0A6D3F1A4CE0E17EFC56A49DCBD3BE48543BCDEB6F9C62C09E8E4E9A0A0B1E6A",
+		LedgerIndex: 316725,
+		Validated:   true,
+	}
+
+	j := `{
+	"amm": {
+		"account": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+		"amount": "296890496",
+		"amount2": {
+			"currency": "TST",
+			"issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd",
+			"value": "25.81656470648473"
+		},
+		"auction_slot": {
+			"account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+			"auth_accounts": [
+				{
+					"account": "r9DKyNWtjXq1a1RFvtzDKvZKWaPn63rS23"
+				}
+			],
+			"discounted_fee": 60,
+			"expiration": "2023-Jan-26 00:28:40.000000000 UTC",
+			"price": {
+				"issuer": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+				"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+				"value": "0"
+			},
+			"time_interval": 0
+		},
+		"lp_token": {
+			"issuer": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+			"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+			"value": "87533.41976112682"
+		},
+		"trading_fee": 600,
+		"vote_slots": [
+			{
+				"account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+				"trading_fee": 600,
+				"vote_weight": 9684
+			}
+		]
+	},
+	"ledger_hash": "0A6D3F1A4CE0E17EFC56A49DCBD3BE48543BCDEB6F9C62C09E8E4E9A0A0B1E6A",
+	"ledger_index": 316725,
+	"validated": true
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
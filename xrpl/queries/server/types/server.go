@@ -10,6 +10,7 @@ type Info struct {
 	BuildVersion             string               `json:"build_version"`
 	CompleteLedgers          string               `json:"complete_ledgers"`
 	ClosedLedger             ClosedLedger         `json:"closed_ledger,omitempty"`
+	ClioVersion              string               `json:"clio_version,omitempty"`
 	HostID                   string               `json:"hostid"`
 	IOLatencyMS              uint                 `json:"io_latency_ms"`
 	JQTransOverflow          string               `json:"jq_trans_overflow"`
@@ -0,0 +1,25 @@
+// Package types contains data structures for subscription stream types.
+//
+//revive:disable:var-naming
+package types
+
+// ManifestStream sends manifestReceived messages whenever the server receives a validator manifest,
+// which links a validator's master key to the ephemeral key it signs validations with.
+type ManifestStream struct {
+	// `manifestReceived` indicates this is from the manifests stream.
+	Type Type `json:"type"`
+	// The base58 encoded public key of the validator's master key pair.
+	MasterKey string `json:"master_key"`
+	// The signature of the manifest by the validator's master key.
+	MasterSignature string `json:"master_signature"`
+	// The serialized manifest, encoded in base64.
+	Manifest string `json:"manifest"`
+	// The sequence number of the manifest. A newer manifest replaces older ones with lower sequence numbers.
+	Seq uint32 `json:"seq"`
+	// The signature of the manifest by the validator's ephemeral key.
+	Signature string `json:"signature"`
+	// The base58 encoded public key of the validator's ephemeral key pair.
+	SigningKey string `json:"signing_key"`
+	// (May be omitted) The domain the validator claims, if any.
+	Domain string `json:"domain,omitempty"`
+}
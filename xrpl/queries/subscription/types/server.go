@@ -0,0 +1,27 @@
+// Package types contains data structures for subscription stream types.
+//
+//revive:disable:var-naming
+package types
+
+// ServerStream sends serverStatus messages whenever the status of the server changes,
+// for example when the server's load changes enough to update its transaction cost.
+type ServerStream struct {
+	// `serverStatus` indicates this is from the server status stream.
+	Type Type `json:"type"`
+	// The minimum transaction cost for a reference transaction, in drops of XRP.
+	BaseFee uint64 `json:"base_fee"`
+	// The baseline amount of server load used in transaction cost calculations.
+	LoadBase uint `json:"load_base"`
+	// The load factor the server is currently enforcing.
+	LoadFactor uint `json:"load_factor"`
+	// The current multiplier to the transaction cost to get into the open ledger, in fee levels.
+	LoadFactorFeeEscalation uint `json:"load_factor_fee_escalation,omitempty"`
+	// The current multiplier to the transaction cost to get into the queue, in fee levels.
+	LoadFactorFeeQueue uint `json:"load_factor_fee_queue,omitempty"`
+	// The transaction cost with no load scaling, in fee levels.
+	LoadFactorFeeReference uint `json:"load_factor_fee_reference,omitempty"`
+	// The load factor the server is enforcing, not including the open ledger cost.
+	LoadFactorServer uint `json:"load_factor_server,omitempty"`
+	// The current state of the server, such as `full` or `syncing`.
+	ServerStatus string `json:"server_status"`
+}
@@ -11,6 +11,11 @@ const (
 	ValidationStreamType  Type = "validationReceived"
 	TransactionStreamType Type = "transaction"
 	PeerStatusStreamType  Type = "peerStatusChange"
-	OrderBookStreamType   Type = TransactionStreamType
 	ConsensusStreamType   Type = "consensusPhase"
+	BookChangesStreamType Type = "bookChanges"
+	ServerStreamType      Type = "serverStatus"
+	ManifestStreamType    Type = "manifestReceived"
+	// OrderBookStreamType is the type of order book stream messages. Order book updates are sent as
+	// transaction messages, so clients tell them apart by matching them against their book subscriptions.
+	OrderBookStreamType Type = TransactionStreamType
 )
@@ -5,4 +5,22 @@ import "errors"
 var (
 	// ErrNoTxBlob is returned when no TxBlob is defined in the SubmitRequest.
 	ErrNoTxBlob = errors.New("no TxBlob defined")
+	// ErrNoSimulateTx is returned when neither the Tx nor the TxBlob is defined in the SimulateRequest.
+	ErrNoSimulateTx = errors.New("no Tx or TxBlob defined")
+	// ErrSimulateTxAndTxBlob is returned when both the Tx and the TxBlob are defined in the SimulateRequest.
+	ErrSimulateTxAndTxBlob = errors.New("only one of Tx or TxBlob can be defined")
+	// ErrSimulateSignedTx is returned when the transaction of the SimulateRequest is signed.
+	ErrSimulateSignedTx = errors.New("transaction to simulate must not be signed")
+	// ErrNoTxIdentifier is returned when neither the Transaction nor the CTID is defined in the TxRequest.
+	ErrNoTxIdentifier = errors.New("no Transaction or CTID defined")
+	// ErrTxHashAndCTID is returned when both the Transaction and the CTID are defined in the TxRequest.
+	ErrTxHashAndCTID = errors.New("only one of Transaction or CTID can be defined")
+	// ErrIncompleteLedgerRange is returned when only one of MinLedger and MaxLedger is defined in the TxRequest.
+	ErrIncompleteLedgerRange = errors.New("both MinLedger and MaxLedger must be defined")
+	// ErrInvalidLedgerRange is returned when the MinLedger of the TxRequest is greater than its MaxLedger.
+	ErrInvalidLedgerRange = errors.New("MinLedger must not be greater than MaxLedger")
+	// ErrExcessiveLedgerRange is returned when the ledger range of the TxRequest spans more than MaxTxLedgerRange ledgers.
+	ErrExcessiveLedgerRange = errors.New("ledger range must not span more than 1000 ledgers")
+	// ErrNoTxHash is returned when no TxHash is defined in the EntryRequest.
+	ErrNoTxHash = errors.New("no TxHash defined")
 )
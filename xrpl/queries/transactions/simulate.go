@@ -0,0 +1,74 @@
+package transactions
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// SimulateRequest is the request type for the simulate command.
+// It executes an unsigned transaction against the current open ledger without submitting it,
+// returning its engine result and metadata. Exactly one of Tx and TxBlob must be set.
+// Fields such as Fee, Sequence, SigningPubKey and NetworkID are autofilled by the server if missing.
+type SimulateRequest struct {
+	common.BaseRequest
+	Tx     transaction.FlatTransaction `json:"tx_json,omitempty"`
+	TxBlob string                      `json:"tx_blob,omitempty"`
+	Binary bool                        `json:"binary,omitempty"`
+}
+
+// Method returns the JSON-RPC method name for the SimulateRequest.
+func (*SimulateRequest) Method() string {
+	return "simulate"
+}
+
+// APIVersion returns the API version required by the SimulateRequest.
+func (*SimulateRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate verifies the SimulateRequest parameters, returning ErrNoSimulateTx if neither the Tx nor the TxBlob
+// is set, ErrSimulateTxAndTxBlob if both are, and ErrSimulateSignedTx if the Tx is signed.
+func (req *SimulateRequest) Validate() error {
+	if len(req.Tx) == 0 && req.TxBlob == "" {
+		return ErrNoSimulateTx
+	}
+	if len(req.Tx) > 0 && req.TxBlob != "" {
+		return ErrSimulateTxAndTxBlob
+	}
+	if sig, _ := req.Tx["TxnSignature"].(string); sig != "" {
+		return ErrSimulateSignedTx
+	}
+	if _, ok := req.Tx["Signers"]; ok {
+		return ErrSimulateSignedTx
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// SimulateResponse is the response type returned by the simulate command.
+// Tx and Meta are set unless the request is binary, in which case TxBlob and MetaBlob are set instead.
+type SimulateResponse struct {
+	EngineResult        string                      `json:"engine_result"`
+	EngineResultCode    int                         `json:"engine_result_code"`
+	EngineResultMessage string                      `json:"engine_result_message"`
+	LedgerIndex         common.LedgerIndex          `json:"ledger_index"`
+	Applied             bool                        `json:"applied"`
+	Tx                  transaction.FlatTransaction `json:"tx_json,omitempty"`
+	TxBlob              string                      `json:"tx_blob,omitempty"`
+	Meta                transaction.TxObjMeta       `json:"meta,omitempty"`
+	MetaBlob            string                      `json:"meta_blob,omitempty"`
+}
+
+// BalanceChanges returns the balance changes the simulated transaction would cause for each account.
+// It's only available if the request isn't binary.
+func (r *SimulateResponse) BalanceChanges() ([]transaction.AccountBalanceChanges, error) {
+	return transaction.GetBalanceChanges(&r.Meta)
+}
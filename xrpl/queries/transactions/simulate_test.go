@@ -0,0 +1,136 @@
+package transactions
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateRequest(t *testing.T) {
+	s := SimulateRequest{
+		Tx: transaction.FlatTransaction{
+			"TransactionType": "Payment",
+			"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			"Amount":          "1000000",
+		},
+		Binary: true,
+	}
+
+	j := `{
+	"tx_json": {
+		"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"Amount": "1000000",
+		"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"TransactionType": "Payment"
+	},
+	"binary": true
+}`
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSimulateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         SimulateRequest
+		expectedErr error
+	}{
+		{
+			name: "pass - unsigned transaction",
+			req:  SimulateRequest{Tx: transaction.FlatTransaction{"TransactionType": "Payment", "SigningPubKey": ""}},
+		},
+		{
+			name: "pass - transaction blob",
+			req:  SimulateRequest{TxBlob: "120000"},
+		},
+		{
+			name:        "fail - no transaction",
+			req:         SimulateRequest{},
+			expectedErr: ErrNoSimulateTx,
+		},
+		{
+			name:        "fail - transaction and blob",
+			req:         SimulateRequest{Tx: transaction.FlatTransaction{"TransactionType": "Payment"}, TxBlob: "120000"},
+			expectedErr: ErrSimulateTxAndTxBlob,
+		},
+		{
+			name:        "fail - signed transaction",
+			req:         SimulateRequest{Tx: transaction.FlatTransaction{"TransactionType": "Payment", "TxnSignature": "3045"}},
+			expectedErr: ErrSimulateSignedTx,
+		},
+		{
+			name:        "fail - multisigned transaction",
+			req:         SimulateRequest{Tx: transaction.FlatTransaction{"TransactionType": "Payment", "Signers": []any{}}},
+			expectedErr: ErrSimulateSignedTx,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.expectedErr)
+		})
+	}
+}
+
+func TestSimulateResponse_BalanceChanges(t *testing.T) {
+	j := `{
+	"engine_result": "tesSUCCESS",
+	"engine_result_code": 0,
+	"engine_result_message": "The simulated transaction would have been applied.",
+	"ledger_index": 3,
+	"applied": false,
+	"tx_json": {
+		"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"Amount": "1000000",
+		"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Fee": "10",
+		"Sequence": 1,
+		"SigningPubKey": "",
+		"TransactionType": "Payment"
+	},
+	"meta": {
+		"AffectedNodes": [
+			{
+				"ModifiedNode": {
+					"FinalFields": {"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "Balance": "98999990"},
+					"LedgerEntryType": "AccountRoot",
+					"LedgerIndex": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+					"PreviousFields": {"Balance": "100000000"}
+				}
+			},
+			{
+				"ModifiedNode": {
+					"FinalFields": {"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "Balance": "11000000"},
+					"LedgerEntryType": "AccountRoot",
+					"LedgerIndex": "4E0AA11CBDD1760DE95B68DF2ABBE75C9698CEB548BEA9789053FCB3EBD444FB",
+					"PreviousFields": {"Balance": "10000000"}
+				}
+			}
+		],
+		"TransactionIndex": 0,
+		"TransactionResult": "tesSUCCESS"
+	}
+}`
+
+	var res SimulateResponse
+	require.NoError(t, json.Unmarshal([]byte(j), &res))
+	require.Equal(t, "tesSUCCESS", res.Meta.TransactionResult)
+
+	changes, err := res.BalanceChanges()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []transaction.AccountBalanceChanges{
+		{
+			Account:  "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			Balances: []transaction.Balance{{Value: "-1.00001", Currency: "XRP"}},
+		},
+		{
+			Account:  "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			Balances: []transaction.Balance{{Value: "1", Currency: "XRP"}},
+		},
+	}, changes)
+}
@@ -2,7 +2,9 @@ package transactions
 
 import (
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
 // ############################################################################
@@ -12,6 +14,7 @@ import (
 // EntryRequest is the request type for the transaction_entry command.
 // It retrieves information on a single transaction from a specific ledger version.
 type EntryRequest struct {
+	common.BaseRequest
 	LedgerHash  common.LedgerHash      `json:"ledger_hash,omitempty"`
 	LedgerIndex common.LedgerSpecifier `json:"ledger_index,omitempty"`
 	TxHash      string                 `json:"tx_hash"`
@@ -22,6 +25,19 @@ func (*EntryRequest) Method() string {
 	return "transaction_entry"
 }
 
+// APIVersion returns the API version for the EntryRequest.
+func (*EntryRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate verifies the EntryRequest parameters, returning ErrNoTxHash if the TxHash isn't set.
+func (req *EntryRequest) Validate() error {
+	if req.TxHash == "" {
+		return ErrNoTxHash
+	}
+	return nil
+}
+
 // ############################################################################
 // Response
 // ############################################################################
@@ -29,8 +45,10 @@ func (*EntryRequest) Method() string {
 // EntryResponse is the response type returned by the transaction_entry command.
 // It contains the ledger index, ledger hash, transaction metadata, and the transaction itself.
 type EntryResponse struct {
-	LedgerIndex common.LedgerIndex          `json:"ledger_index"`
-	LedgerHash  common.LedgerHash           `json:"ledger_hash,omitempty"`
-	Metadata    transaction.TxObjMeta       `json:"metadata"`
-	Tx          transaction.FlatTransaction `json:"tx_json"`
+	CloseTimeISO string                      `json:"close_time_iso,omitempty"`
+	Hash         types.Hash256               `json:"hash,omitempty"`
+	LedgerIndex  common.LedgerIndex          `json:"ledger_index"`
+	LedgerHash   common.LedgerHash           `json:"ledger_hash,omitempty"`
+	Metadata     transaction.TxObjMeta       `json:"metadata"`
+	Tx           transaction.FlatTransaction `json:"tx_json"`
 }
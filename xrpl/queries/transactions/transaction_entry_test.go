@@ -0,0 +1,88 @@
+package transactions
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryRequest(t *testing.T) {
+	s := EntryRequest{
+		LedgerIndex: common.LedgerIndex(56865245),
+		TxHash:      "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+	}
+
+	j := `{
+	"ledger_index": 56865245,
+	"tx_hash": "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2"
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEntryResponse(t *testing.T) {
+	s := EntryResponse{
+		CloseTimeISO: "2020-07-03T17:12:10Z",
+		Hash:         "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+		LedgerIndex:  56865245,
+		LedgerHash:   "793E56131D8D4ABFB27FA383BFC44F2978B046E023FF46C588D7E0C874C2472A",
+		Metadata: transaction.TxObjMeta{
+			AffectedNodes:     []transaction.AffectedNode{},
+			TransactionIndex:  0,
+			TransactionResult: "tesSUCCESS",
+		},
+		Tx: transaction.FlatTransaction{
+			"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			"TransactionType": "AccountSet",
+		},
+	}
+
+	j := `{
+	"close_time_iso": "2020-07-03T17:12:10Z",
+	"hash": "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+	"ledger_index": 56865245,
+	"ledger_hash": "793E56131D8D4ABFB27FA383BFC44F2978B046E023FF46C588D7E0C874C2472A",
+	"metadata": {
+		"AffectedNodes": [],
+		"TransactionIndex": 0,
+		"TransactionResult": "tesSUCCESS"
+	},
+	"tx_json": {
+		"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"TransactionType": "AccountSet"
+	}
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEntryRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         EntryRequest
+		expectedErr error
+	}{
+		{
+			name: "pass - hash",
+			req:  EntryRequest{TxHash: "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2"},
+		},
+		{
+			name:        "fail - no hash",
+			req:         EntryRequest{LedgerIndex: common.Validated},
+			expectedErr: ErrNoTxHash,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.expectedErr)
+		})
+	}
+}
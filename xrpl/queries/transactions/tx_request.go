@@ -11,11 +11,18 @@ import (
 // Request
 // ############################################################################
 
+// MaxTxLedgerRange is the maximum number of ledgers the MinLedger to MaxLedger range of a TxRequest can span.
+const MaxTxLedgerRange = 1000
+
 // TxRequest is the request type for the tx command.
-// It retrieves information on a single transaction by its identifying hash.
+// It retrieves information on a single transaction by its identifying hash, or by its compact
+// transaction identifier (CTID). Exactly one of Transaction and CTID must be set.
+// MinLedger and MaxLedger, if set, make the server report whether it searched the whole range
+// when the transaction isn't found.
 type TxRequest struct {
 	common.BaseRequest
-	Transaction string             `json:"transaction"`
+	Transaction string             `json:"transaction,omitempty"`
+	CTID        string             `json:"ctid,omitempty"`
 	Binary      bool               `json:"binary,omitempty"`
 	MinLedger   common.LedgerIndex `json:"min_ledger,omitempty"`
 	MaxLedger   common.LedgerIndex `json:"max_ledger,omitempty"`
@@ -31,9 +38,25 @@ func (*TxRequest) APIVersion() int {
 	return version.RippledAPIV2
 }
 
-// Validate verifies the TxRequest parameters.
-// TODO: implement V2 validation logic.
-func (*TxRequest) Validate() error {
+// Validate verifies the TxRequest parameters, returning ErrNoTxIdentifier if neither the Transaction nor the
+// CTID is set, ErrTxHashAndCTID if both are, and an error if the ledger range is incomplete, inverted or
+// spans more than MaxTxLedgerRange ledgers.
+func (req *TxRequest) Validate() error {
+	if req.Transaction == "" && req.CTID == "" {
+		return ErrNoTxIdentifier
+	}
+	if req.Transaction != "" && req.CTID != "" {
+		return ErrTxHashAndCTID
+	}
+	if (req.MinLedger == 0) != (req.MaxLedger == 0) {
+		return ErrIncompleteLedgerRange
+	}
+	if req.MinLedger > req.MaxLedger {
+		return ErrInvalidLedgerRange
+	}
+	if req.MaxLedger-req.MinLedger > MaxTxLedgerRange {
+		return ErrExcessiveLedgerRange
+	}
 	return nil
 }
 
@@ -42,12 +65,19 @@ func (*TxRequest) Validate() error {
 // ############################################################################
 
 // TxResponse is the response type returned by the tx command.
-// It includes transaction details, metadata, and validation status.
+// It includes transaction details, metadata, and validation status. TxJSON and Meta are set unless the
+// request is binary, in which case TxBlob and MetaBlob are set instead. Meta.AsTxObjMeta returns the
+// metadata as a TxObjMeta.
 type TxResponse struct {
-	Date        uint                          `json:"date"`
-	Hash        types.Hash256                 `json:"hash"`
-	LedgerIndex common.LedgerIndex            `json:"ledger_index"`
-	Meta        transaction.TxMetadataBuilder `json:"meta"`
-	Validated   bool                          `json:"validated"`
-	TxJSON      transaction.FlatTransaction   `json:"tx_json,omitempty"`
+	Date         uint                          `json:"date"`
+	CloseTimeISO string                        `json:"close_time_iso,omitempty"`
+	CTID         string                        `json:"ctid,omitempty"`
+	Hash         types.Hash256                 `json:"hash"`
+	LedgerHash   common.LedgerHash             `json:"ledger_hash,omitempty"`
+	LedgerIndex  common.LedgerIndex            `json:"ledger_index"`
+	Meta         transaction.TxMetadataBuilder `json:"meta"`
+	MetaBlob     string                        `json:"meta_blob,omitempty"`
+	Validated    bool                          `json:"validated"`
+	TxJSON       transaction.FlatTransaction   `json:"tx_json,omitempty"`
+	TxBlob       string                        `json:"tx_blob,omitempty"`
 }
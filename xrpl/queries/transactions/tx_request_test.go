@@ -0,0 +1,120 @@
+package transactions
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxRequest(t *testing.T) {
+	s := TxRequest{
+		CTID:      "C005523E00000000",
+		Binary:    true,
+		MinLedger: 348734,
+		MaxLedger: 348834,
+	}
+
+	j := `{
+	"ctid": "C005523E00000000",
+	"binary": true,
+	"min_ledger": 348734,
+	"max_ledger": 348834
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTxResponse(t *testing.T) {
+	s := TxResponse{
+		Date:         797010521,
+		CloseTimeISO: "2025-04-03T16:28:41Z",
+		CTID:         "C005523E00000000",
+		Hash:         "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+		LedgerHash:   "D1CB1B1A4D8E4C2B5C8A1BD6D4C5E2F8A9B0C1D2E3F4A5B6C7D8E9F0A1B2C3D4",
+		LedgerIndex:  348734,
+		Meta: transaction.TxMetadataBuilder{
+			AffectedNodes:     []transaction.AffectedNode{},
+			TransactionIndex:  0,
+			TransactionResult: "tesSUCCESS",
+		},
+		Validated: true,
+		TxJSON: transaction.FlatTransaction{
+			"Account":         "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			"TransactionType": "AccountSet",
+		},
+	}
+
+	j := `{
+	"date": 797010521,
+	"close_time_iso": "2025-04-03T16:28:41Z",
+	"ctid": "C005523E00000000",
+	"hash": "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+	"ledger_hash": "D1CB1B1A4D8E4C2B5C8A1BD6D4C5E2F8A9B0C1D2E3F4A5B6C7D8E9F0A1B2C3D4",
+	"ledger_index": 348734,
+	"meta": {
+		"AffectedNodes": [],
+		"TransactionResult": "tesSUCCESS"
+	},
+	"validated": true,
+	"tx_json": {
+		"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"TransactionType": "AccountSet"
+	}
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTxRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         TxRequest
+		expectedErr error
+	}{
+		{
+			name: "pass - hash",
+			req:  TxRequest{Transaction: "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2"},
+		},
+		{
+			name: "pass - CTID with ledger range",
+			req:  TxRequest{CTID: "C005523E00000000", MinLedger: 348000, MaxLedger: 349000},
+		},
+		{
+			name:        "fail - no hash or CTID",
+			req:         TxRequest{Binary: true},
+			expectedErr: ErrNoTxIdentifier,
+		},
+		{
+			name:        "fail - hash and CTID",
+			req:         TxRequest{Transaction: "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2", CTID: "C005523E00000000"},
+			expectedErr: ErrTxHashAndCTID,
+		},
+		{
+			name:        "fail - incomplete ledger range",
+			req:         TxRequest{CTID: "C005523E00000000", MinLedger: 348000},
+			expectedErr: ErrIncompleteLedgerRange,
+		},
+		{
+			name:        "fail - inverted ledger range",
+			req:         TxRequest{CTID: "C005523E00000000", MinLedger: 349000, MaxLedger: 348000},
+			expectedErr: ErrInvalidLedgerRange,
+		},
+		{
+			name:        "fail - excessive ledger range",
+			req:         TxRequest{CTID: "C005523E00000000", MinLedger: 348000, MaxLedger: 349001},
+			expectedErr: ErrExcessiveLedgerRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.expectedErr)
+		})
+	}
+}
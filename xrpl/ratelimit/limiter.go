@@ -0,0 +1,141 @@
+// Package ratelimit implements the client-side rate limiter used by the rpc and websocket clients
+// to throttle the requests sent to an XRPL server.
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+)
+
+// Limiter is a token bucket rate limiter. Tokens are added at the configured rate, divided by the
+// load factor of the server, up to the burst size. Every request takes a token.
+// A nil Limiter doesn't limit anything.
+type Limiter struct {
+	mu         sync.Mutex
+	rate       float64
+	burst      float64
+	loadFactor float64
+	tokens     float64
+	last       time.Time
+}
+
+// New returns a Limiter allowing rate requests per second, with bursts of up to burst requests.
+// It returns nil, which doesn't limit anything, if rate is not positive.
+func New(rate float64, burst int) *Limiter {
+	if rate <= 0 {
+		return nil
+	}
+	b := float64(max(burst, 1))
+	return &Limiter{
+		rate:       rate,
+		burst:      b,
+		loadFactor: 1,
+		tokens:     b,
+		last:       time.Now(),
+	}
+}
+
+// Rate returns the number of requests per second currently allowed, that is, the configured rate
+// divided by the load factor.
+func (l *Limiter) Rate() float64 {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.currentRate()
+}
+
+// SetLoadFactor sets the load factor of the server, relative to its normal load. The rate of the
+// limiter is divided by it, so that clients slow down when the server is busy.
+// Load factors lower than 1 are treated as 1.
+func (l *Limiter) SetLoadFactor(loadFactor float64) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.advance(time.Now())
+	l.loadFactor = max(loadFactor, 1)
+}
+
+// Wait blocks until a request is allowed or ctx is done, in which case it returns the error of ctx.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	l.advance(time.Now())
+	l.tokens--
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.currentRate() * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give the reserved token back.
+		l.mu.Lock()
+		l.tokens = min(l.tokens+1, l.burst)
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// advance adds the tokens accumulated since the last update.
+func (l *Limiter) advance(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+	if elapsed > 0 {
+		l.tokens = min(l.tokens+elapsed*l.currentRate(), l.burst)
+	}
+}
+
+func (l *Limiter) currentRate() float64 {
+	return l.rate / l.loadFactor
+}
+
+// LoadFactorFromServerInfo returns the load factor of a server_info result. It reports false if the
+// result doesn't contain one.
+func LoadFactorFromServerInfo(result map[string]any) (float64, bool) {
+	info, ok := result["info"].(map[string]any)
+	if !ok {
+		return 0, false
+	}
+	switch loadFactor := info["load_factor"].(type) {
+	case float64:
+		return loadFactor, true
+	case json.Number:
+		f, err := loadFactor.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// LoadFactorFromServerStream returns the load factor of the server, relative to its normal load, from a
+// server stream message.
+func LoadFactorFromServerStream(server *streamtypes.ServerStream) float64 {
+	loadFactor := server.LoadFactor
+	if server.LoadFactorServer != 0 {
+		loadFactor = server.LoadFactorServer
+	}
+	if server.LoadBase == 0 || loadFactor == 0 {
+		return 1
+	}
+	return float64(loadFactor) / float64(server.LoadBase)
+}
@@ -0,0 +1,181 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name          string
+		rate          float64
+		burst         int
+		expectedNil   bool
+		expectedBurst float64
+	}{
+		{
+			name:          "pass - rate and burst",
+			rate:          10,
+			burst:         5,
+			expectedBurst: 5,
+		},
+		{
+			name:          "pass - burst of at least one request",
+			rate:          10,
+			burst:         0,
+			expectedBurst: 1,
+		},
+		{
+			name:        "pass - no limit without rate",
+			rate:        0,
+			burst:       5,
+			expectedNil: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := New(tc.rate, tc.burst)
+			if tc.expectedNil {
+				require.Nil(t, l)
+				return
+			}
+			require.Equal(t, tc.expectedBurst, l.burst)
+			require.Equal(t, tc.expectedBurst, l.tokens)
+			require.Equal(t, tc.rate, l.Rate())
+		})
+	}
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	t.Run("pass - burst is not delayed", func(t *testing.T) {
+		l := New(1, 3)
+		start := time.Now()
+		for range 3 {
+			require.NoError(t, l.Wait(context.Background()))
+		}
+		require.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("pass - requests beyond the burst wait for a token", func(t *testing.T) {
+		l := New(50, 1)
+		start := time.Now()
+		for range 3 {
+			require.NoError(t, l.Wait(context.Background()))
+		}
+		// The 2 requests after the burst wait 20ms each.
+		require.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+	})
+
+	t.Run("pass - load factor slows requests down", func(t *testing.T) {
+		l := New(100, 1)
+		l.SetLoadFactor(4)
+		require.Equal(t, float64(25), l.Rate())
+
+		start := time.Now()
+		for range 2 {
+			require.NoError(t, l.Wait(context.Background()))
+		}
+		require.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+	})
+
+	t.Run("pass - load factor below 1 is ignored", func(t *testing.T) {
+		l := New(100, 1)
+		l.SetLoadFactor(0.5)
+		require.Equal(t, float64(100), l.Rate())
+	})
+
+	t.Run("fail - context done while waiting", func(t *testing.T) {
+		l := New(1, 1)
+		require.NoError(t, l.Wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+
+		// The token reserved by the cancelled request is given back.
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		require.Greater(t, l.tokens, float64(-1))
+	})
+
+	t.Run("pass - nil limiter", func(t *testing.T) {
+		var l *Limiter
+		require.NoError(t, l.Wait(context.Background()))
+		l.SetLoadFactor(2)
+		require.Zero(t, l.Rate())
+	})
+}
+
+func TestLoadFactorFromServerInfo(t *testing.T) {
+	testCases := []struct {
+		name       string
+		result     map[string]any
+		expected   float64
+		expectedOk bool
+	}{
+		{
+			name:       "pass - float load factor",
+			result:     map[string]any{"info": map[string]any{"load_factor": float64(2.5)}},
+			expected:   2.5,
+			expectedOk: true,
+		},
+		{
+			name:       "pass - json number load factor",
+			result:     map[string]any{"info": map[string]any{"load_factor": json.Number("3")}},
+			expected:   3,
+			expectedOk: true,
+		},
+		{
+			name:   "fail - missing load factor",
+			result: map[string]any{"info": map[string]any{}},
+		},
+		{
+			name:   "fail - missing info",
+			result: map[string]any{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loadFactor, ok := LoadFactorFromServerInfo(tc.result)
+			require.Equal(t, tc.expectedOk, ok)
+			require.Equal(t, tc.expected, loadFactor)
+		})
+	}
+}
+
+func TestLoadFactorFromServerStream(t *testing.T) {
+	testCases := []struct {
+		name     string
+		server   *streamtypes.ServerStream
+		expected float64
+	}{
+		{
+			name:     "pass - normal load",
+			server:   &streamtypes.ServerStream{LoadBase: 256, LoadFactor: 256},
+			expected: 1,
+		},
+		{
+			name:     "pass - server load preferred over load factor",
+			server:   &streamtypes.ServerStream{LoadBase: 256, LoadFactor: 1024, LoadFactorServer: 512},
+			expected: 2,
+		},
+		{
+			name:     "pass - missing load base",
+			server:   &streamtypes.ServerStream{LoadFactor: 512},
+			expected: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, LoadFactorFromServerStream(tc.server))
+		})
+	}
+}
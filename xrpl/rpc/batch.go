@@ -0,0 +1,223 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// BatchResult holds the response, or the error, of one of the requests sent with BatchRequest.
+type BatchResult struct {
+	Response XRPLResponse
+	Err      error
+}
+
+// batchMethod is the method of the envelope of a JSON-RPC batch, also reported to interceptors.
+const batchMethod = "batch"
+
+// batchEnvelope is the body of a JSON-RPC batch: a request of the batch method whose params are the
+// requests of the batch.
+type batchEnvelope struct {
+	Method string         `json:"method"`
+	Params []batchRequest `json:"params"`
+}
+
+// batchRequest is an element of a JSON-RPC batch. The ID matches the response to its request.
+type batchRequest struct {
+	ID     int    `json:"id"`
+	Method string `json:"method"`
+	Params []any  `json:"params,omitempty"`
+}
+
+// batchResponse is an element of the response to a JSON-RPC batch.
+type batchResponse struct {
+	ID *int `json:"id,omitempty"`
+	Response
+}
+
+// BatchRequest sends several requests to the server and returns their results in the order of reqs.
+// If JSON-RPC batches are enabled with WithJSONRPCBatch, the requests are sent as a single batch request
+// in one POST. Otherwise, or if the server rejects the batch, they are sent concurrently by up to the
+// number of workers set with WithBatchConcurrency.
+func (c *Client) BatchRequest(reqs ...XRPLRequest) []BatchResult {
+	return c.BatchRequestContext(context.Background(), reqs...)
+}
+
+// BatchRequestContext is like BatchRequest but uses ctx to cancel the requests and propagate deadlines.
+func (c *Client) BatchRequestContext(ctx context.Context, reqs ...XRPLRequest) []BatchResult {
+	results := make([]BatchResult, len(reqs))
+
+	pending := make([]int, len(reqs))
+	for i := range reqs {
+		pending[i] = i
+	}
+	if c.cfg.jsonRPCBatch && len(reqs) > 1 {
+		pending = c.sendBatch(ctx, reqs, results)
+	}
+
+	workers := max(c.cfg.batchConcurrency, 1)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(pending)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				res, err := c.RequestContext(ctx, reqs[i])
+				results[i] = BatchResult{Response: res, Err: err}
+			}
+		}()
+	}
+	for _, i := range pending {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// sendBatch sends the valid requests as a single JSON-RPC batch and fills the results of every request.
+// If the server rejects the batch or doesn't answer every request of it, no result of a valid request is
+// filled and their indexes are returned, so that they are sent one by one.
+func (c *Client) sendBatch(ctx context.Context, reqs []XRPLRequest, results []BatchResult) []int {
+	batch := make([]batchRequest, 0, len(reqs))
+	for i, req := range reqs {
+		if err := req.Validate(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		elem, err := newBatchRequest(i, req)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		batch = append(batch, elem)
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	pending := make([]int, len(batch))
+	for i, elem := range batch {
+		pending[i] = elem.ID
+	}
+
+	body, err := jsoniter.Marshal(batchEnvelope{Method: batchMethod, Params: batch})
+	if err != nil {
+		return pending
+	}
+
+	// Every request of the batch counts towards the rate limit.
+	for range batch {
+		if err := c.cfg.rateLimiter.Wait(ctx); err != nil {
+			for _, idx := range pending {
+				results[idx].Err = err
+			}
+			return nil
+		}
+	}
+
+	// add timeout context to prevent hanging
+	if c.cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.timeout)
+		defer cancel()
+	}
+
+	event := &xrpl.RequestEvent{Method: batchMethod, ID: c.requestID.Add(1), Payload: body}
+	ctx = c.cfg.interceptors.BeforeRequest(ctx, event)
+	start := time.Now()
+
+	var answers map[int]*Response
+	response, payload, err := c.post(ctx, body)
+	if err == nil {
+		answers, err = decodeBatchResponses(response, payload, batch)
+	}
+
+	c.cfg.interceptors.Complete(ctx, &xrpl.ResponseEvent{
+		Request: event,
+		Latency: time.Since(start),
+		Payload: payload,
+		Err:     err,
+	})
+	if err != nil {
+		c.cfg.log().WarnContext(ctx, "JSON-RPC batch failed, sending requests one by one",
+			"requests", len(batch),
+			"error", err,
+		)
+		return pending
+	}
+
+	// Every request is answered: none of them is sent again.
+	for idx, res := range answers {
+		if _, ok := res.Result["error"]; ok {
+			results[idx] = BatchResult{Err: newXRPLError(res.Result)}
+			continue
+		}
+		c.observeLoadFactor(reqs[idx].Method(), res.Result)
+		results[idx] = BatchResult{Response: res}
+	}
+	return nil
+}
+
+// decodeBatchResponses decodes the response to a JSON-RPC batch and matches every response to the index
+// of its request. It fails with ErrJSONRPCBatchRejected unless every request of the batch is answered once.
+func decodeBatchResponses(response *http.Response, payload []byte, batch []batchRequest) (map[int]*Response, error) {
+	if response == nil || response.StatusCode != http.StatusOK {
+		return nil, ErrJSONRPCBatchRejected
+	}
+
+	var responses []batchResponse
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	if err := dec.Decode(&responses); err != nil || len(responses) != len(batch) {
+		return nil, ErrJSONRPCBatchRejected
+	}
+
+	sent := make(map[int]bool, len(batch))
+	for _, elem := range batch {
+		sent[elem.ID] = true
+	}
+	answers := make(map[int]*Response, len(batch))
+	for i := range responses {
+		// Responses are matched by ID, falling back to their position if the server doesn't echo it.
+		idx := batch[i].ID
+		if responses[i].ID != nil {
+			idx = *responses[i].ID
+		}
+		if !sent[idx] {
+			return nil, ErrJSONRPCBatchRejected
+		}
+		delete(sent, idx)
+		answers[idx] = &responses[i].Response
+	}
+	return answers, nil
+}
+
+// newBatchRequest formats req as the element id of a JSON-RPC batch.
+func newBatchRequest(id int, req XRPLRequest) (batchRequest, error) {
+	req.SetAPIVersion(req.APIVersion())
+
+	elem := batchRequest{ID: id, Method: req.Method()}
+
+	params, err := jsoniter.Marshal(req)
+	if err != nil {
+		return elem, ErrFailedToMarshalJSONRPCRequest{
+			Method: req.Method(),
+			Params: req,
+			Err:    err,
+		}
+	}
+	// Omit the params field if the method doesn't require any
+	if string(params) != "{}" {
+		elem.Params = []any{req}
+	}
+	return elem, nil
+}
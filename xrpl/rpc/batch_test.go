@@ -0,0 +1,257 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errInvalidTestRequest = errors.New("invalid request")
+
+// invalidRequest is a request that always fails validation.
+type invalidRequest struct {
+	account.InfoRequest
+}
+
+func (*invalidRequest) Validate() error {
+	return errInvalidTestRequest
+}
+
+// accountResult returns the result of an account_info response for the given params, or an actNotFound
+// error for the unknown account.
+func accountResult(params []map[string]any) map[string]any {
+	acc, _ := params[0]["account"].(string)
+	if acc == "rUnknown" {
+		return map[string]any{"error": "actNotFound", "error_code": 19, "status": "error"}
+	}
+	return map[string]any{"account_data": map[string]any{"Account": acc}, "status": "success"}
+}
+
+// jsonResponse returns a successful HTTP response with the given body. Unlike testutil.MockResponse,
+// it can be used from concurrent requests.
+func jsonResponse(body []byte) *http.Response {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}
+}
+
+// batchBody is the body of a request as decoded by the mock server. The params of a JSON-RPC batch
+// are its requests.
+type batchBody struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type batchElementBody struct {
+	ID     int              `json:"id"`
+	Method string           `json:"method"`
+	Params []map[string]any `json:"params"`
+}
+
+func batchAccounts() []XRPLRequest {
+	return []XRPLRequest{
+		&account.InfoRequest{Account: types.Address("rAccount1")},
+		&account.InfoRequest{Account: types.Address("rUnknown")},
+		&invalidRequest{},
+		&account.InfoRequest{Account: types.Address("rAccount2")},
+	}
+}
+
+func requireBatchResults(t *testing.T, results []BatchResult) {
+	t.Helper()
+	require.Len(t, results, 4)
+
+	for i, expected := range map[int]string{0: "rAccount1", 3: "rAccount2"} {
+		require.NoError(t, results[i].Err)
+		var res account.InfoResponse
+		require.NoError(t, results[i].Response.GetResult(&res))
+		require.Equal(t, types.Address(expected), res.AccountData.Account)
+	}
+	require.ErrorIs(t, results[1].Err, xrpl.ErrActNotFound)
+	require.Nil(t, results[1].Response)
+	require.ErrorIs(t, results[2].Err, errInvalidTestRequest)
+}
+
+func TestClient_BatchRequest(t *testing.T) {
+	t.Run("pass - requests sent concurrently with bounded workers", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+		var requests atomic.Int32
+
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+			requests.Add(1)
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			var body struct {
+				Params []map[string]any `json:"params"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			res, _ := json.Marshal(map[string]any{"result": accountResult(body.Params)})
+			return jsonResponse(res), nil
+		}
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithBatchConcurrency(2))
+		require.NoError(t, err)
+
+		results := NewClient(cfg).BatchRequest(batchAccounts()...)
+
+		requireBatchResults(t, results)
+		require.Equal(t, int32(3), requests.Load())
+		require.LessOrEqual(t, maxInFlight.Load(), int32(2))
+	})
+
+	t.Run("pass - requests sent as a JSON-RPC batch", func(t *testing.T) {
+		var requests atomic.Int32
+
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+			requests.Add(1)
+
+			var body batchBody
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			require.Equal(t, "batch", body.Method)
+			var batch []batchElementBody
+			require.NoError(t, json.Unmarshal(body.Params, &batch))
+			require.Len(t, batch, 3)
+
+			// Answer in reverse order: results must be matched by id.
+			responses := make([]map[string]any, 0, len(batch))
+			for i := len(batch) - 1; i >= 0; i-- {
+				elem := batch[i]
+				require.Equal(t, "account_info", elem.Method)
+				responses = append(responses, map[string]any{"id": elem.ID, "result": accountResult(elem.Params)})
+			}
+			res, _ := json.Marshal(responses)
+			return jsonResponse(res), nil
+		}
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithJSONRPCBatch(true))
+		require.NoError(t, err)
+
+		results := NewClient(cfg).BatchRequest(batchAccounts()...)
+
+		requireBatchResults(t, results)
+		require.Equal(t, int32(1), requests.Load())
+	})
+
+	fallbackTests := []struct {
+		name  string
+		batch func(batch []batchElementBody) []byte
+	}{
+		{
+			name: "pass - falls back to concurrent requests when the batch is rejected",
+			batch: func([]batchElementBody) []byte {
+				return []byte(`{"result": {"error": "unknownCmd", "status": "error"}}`)
+			},
+		},
+		{
+			name: "pass - falls back to concurrent requests when a response of the batch is unknown",
+			batch: func(batch []batchElementBody) []byte {
+				responses := make([]map[string]any, 0, len(batch))
+				for _, elem := range batch {
+					responses = append(responses, map[string]any{"id": elem.ID, "result": accountResult(elem.Params)})
+				}
+				responses[len(responses)-1]["id"] = 42
+				res, _ := json.Marshal(responses)
+				return res
+			},
+		},
+	}
+
+	for _, tc := range fallbackTests {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var batches int
+			sent := map[string]int{}
+
+			mc := &testutil.JSONRPCMockClient{}
+			mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+				var body batchBody
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+				mu.Lock()
+				defer mu.Unlock()
+				if body.Method == "batch" {
+					batches++
+					var batch []batchElementBody
+					require.NoError(t, json.Unmarshal(body.Params, &batch))
+					return jsonResponse(tc.batch(batch)), nil
+				}
+
+				var params []map[string]any
+				require.NoError(t, json.Unmarshal(body.Params, &params))
+				acc, _ := params[0]["account"].(string)
+				sent[acc]++
+
+				res, _ := json.Marshal(map[string]any{"result": accountResult(params)})
+				return jsonResponse(res), nil
+			}
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithJSONRPCBatch(true))
+			require.NoError(t, err)
+
+			results := NewClient(cfg).BatchRequest(batchAccounts()...)
+
+			requireBatchResults(t, results)
+			require.Equal(t, 1, batches)
+			// Every valid request is sent once after the batch, and none of them twice.
+			require.Equal(t, map[string]int{"rAccount1": 1, "rUnknown": 1, "rAccount2": 1}, sent)
+		})
+	}
+
+	t.Run("pass - empty batch", func(t *testing.T) {
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&testutil.JSONRPCMockClient{}))
+		require.NoError(t, err)
+
+		require.Empty(t, NewClient(cfg).BatchRequest())
+	})
+}
+
+func TestNewBatchRequest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      XRPLRequest
+		expected string
+	}{
+		{
+			name:     "pass - request with params",
+			req:      &account.InfoRequest{Account: types.Address("rAccount1")},
+			expected: `{"id":1,"method":"account_info","params":[{"account":"rAccount1","api_version":2}]}`,
+		},
+		{
+			name:     "pass - request with zero value params",
+			req:      &utility.RandomRequest{},
+			expected: `{"id":1,"method":"random","params":[{"api_version":2}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			elem, err := newBatchRequest(1, tc.req)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(elem)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expected, string(b))
+		})
+	}
+}
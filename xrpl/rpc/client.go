@@ -4,17 +4,21 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/engine"
 	"github.com/Peersyst/xrpl-go/xrpl/hash"
-	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/ratelimit"
 	rpctypes "github.com/Peersyst/xrpl-go/xrpl/rpc/types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
-	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 
 	"github.com/Peersyst/xrpl-go/xrpl/wallet"
 )
@@ -23,18 +27,41 @@ import (
 type Client struct {
 	cfg *Config
 
+	// requestID numbers the HTTP requests sent, to identify them in interceptors.
+	requestID atomic.Uint64
+
+	// clio caches whether the server is a Clio server, for the Clio-only methods.
+	clio engine.ClioProbe
+
+	// eng autofills, submits and waits for transactions.
+	eng *engine.Engine
+
+	// Methods provides the pagination, ledger entry and Clio methods of the client.
+	engine.Methods
+
 	NetworkID uint32
 }
 
+// Client implements the transport-agnostic xrpl.Client interface.
+var _ xrpl.Client = (*Client)(nil)
+
 // NewClient creates a new RPC Client with the given configuration.
 func NewClient(cfg *Config) *Client {
-	return &Client{
+	c := &Client{
 		cfg: cfg,
 	}
+	c.eng = newEngine(c)
+	c.Methods = engine.NewMethods(c.eng)
+	return c
 }
 
 // Request sends a request to the XRPL server and returns the response and any error encountered.
 func (c *Client) Request(reqParams XRPLRequest) (XRPLResponse, error) {
+	return c.RequestContext(context.Background(), reqParams)
+}
+
+// RequestContext is like Request but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) RequestContext(ctx context.Context, reqParams XRPLRequest) (XRPLResponse, error) {
 
 	err := reqParams.Validate()
 	if err != nil {
@@ -46,66 +73,113 @@ func (c *Client) Request(reqParams XRPLRequest) (XRPLResponse, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.cfg.URL, bytes.NewReader(body))
-	if err != nil {
-		return nil, err
+	policy := c.cfg.retryPolicy
+	if policy == nil {
+		policy = &RetryPolicy{}
 	}
 
-	// add timeout context to prevent hanging
-	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.timeout)
-	defer cancel()
-	req = req.WithContext(ctx)
-
-	req.Header = c.cfg.Headers
+	for attempt := 0; ; attempt++ {
+		if err := c.cfg.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
 
-	var response *http.Response
+		jr, response, err := c.send(ctx, reqParams.Method(), body)
+		if err == nil {
+			c.observeLoadFactor(reqParams.Method(), jr.Result)
+			return &jr, nil
+		}
+		if !policy.shouldRetry(attempt, response, err) {
+			return nil, err
+		}
 
-	response, err = c.cfg.HTTPClient.Do(req)
-	if err != nil || response == nil {
-		return nil, err
+		delay := policy.delay(attempt, response)
+		c.cfg.log().WarnContext(ctx, "retrying request",
+			"method", reqParams.Method(),
+			"attempt", attempt+1,
+			"delay", delay,
+			"error", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %w", ctx.Err(), err)
+		case <-time.After(delay):
+		}
 	}
+}
 
-	// allow client to reuse persistent connection
-	defer func() {
-		_ = response.Body.Close()
-	}()
-	// Check for service unavailable response and retry if so
-	if response.StatusCode == 503 {
+// observeLoadFactor adapts the rate limit to the load factor of server_info responses, if enabled.
+func (c *Client) observeLoadFactor(method string, result AnyJSON) {
+	if !c.cfg.loadFactorThrottling || method != "server_info" {
+		return
+	}
+	if loadFactor, ok := ratelimit.LoadFactorFromServerInfo(result); ok {
+		c.cfg.rateLimiter.SetLoadFactor(loadFactor)
+	}
+}
 
-		maxRetries := 3
-		backoffDuration := 1 * time.Second
+// send posts the request body to the server once, notifying the configured interceptors.
+// The body is read again on every call, so send can be called for every retry of a request.
+// The timeout set with WithTimeout applies to every call separately, not to the retries and their backoff.
+func (c *Client) send(ctx context.Context, method string, body []byte) (Response, *http.Response, error) {
+	// add timeout context to prevent hanging
+	if c.cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.timeout)
+		defer cancel()
+	}
 
-		for i := 0; i < maxRetries; i++ {
-			time.Sleep(backoffDuration)
+	event := &xrpl.RequestEvent{Method: method, ID: c.requestID.Add(1), Payload: body}
+	ctx = c.cfg.interceptors.BeforeRequest(ctx, event)
+	start := time.Now()
 
-			// Make request again after waiting
-			response, err = c.cfg.HTTPClient.Do(req)
-			if err != nil {
-				return nil, err
-			}
+	var jr Response
+	response, payload, err := c.post(ctx, body)
+	if err == nil && response != nil {
+		if response.StatusCode == http.StatusServiceUnavailable {
+			err = &ClientError{ErrorString: "Server is overloaded, rate limit exceeded"}
+		} else {
+			jr, err = checkForError(response)
+		}
+	}
 
-			if response.StatusCode != 503 {
-				break
-			}
+	c.cfg.interceptors.Complete(ctx, &xrpl.ResponseEvent{
+		Request: event,
+		Latency: time.Since(start),
+		Payload: payload,
+		Err:     err,
+	})
+	return jr, response, err
+}
 
-			// Increase backoff duration for the next retry
-			backoffDuration *= 2
-		}
+// post sends the body to the server and reads the whole response. The body of the returned
+// response can be read again.
+func (c *Client) post(ctx context.Context, body []byte) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
 
-		if response.StatusCode == 503 {
-			// Return service unavailable error here after retry 3 times
-			return nil, &ClientError{ErrorString: "Server is overloaded, rate limit exceeded"}
-		}
+	req.Header = c.cfg.Headers
 
+	response, err := c.cfg.HTTPClient.Do(req)
+	if err != nil || response == nil {
+		return nil, nil, err
 	}
 
-	var jr Response
-	jr, err = checkForError(response)
+	// allow client to reuse persistent connection
+	respBody := response.Body
+	defer func() {
+		_ = respBody.Close()
+	}()
+
+	payload, err := io.ReadAll(respBody)
 	if err != nil {
-		return nil, err
+		return response, nil, err
 	}
+	response.Body = io.NopCloser(bytes.NewReader(payload))
 
-	return &jr, nil
+	return response, payload, nil
 }
 
 // SubmitTxBlob sends a pre-signed transaction blob to the server.
@@ -113,22 +187,12 @@ func (c *Client) Request(reqParams XRPLRequest) (XRPLResponse, error) {
 // or a signing public key, and then submits it using a submission request.
 // The failHard flag determines how strictly errors are handled.
 func (c *Client) SubmitTxBlob(txBlob string, failHard bool) (*requests.SubmitResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
-	if err != nil {
-		return nil, err
-	}
-
-	_, okTxSig := tx["TxSignature"].(string)
-	_, okPubKey := tx["SigningPubKey"].(string)
-
-	if !okTxSig && !okPubKey {
-		return nil, ErrMissingTxSignatureOrSigningPubKey
-	}
+	return c.SubmitTxBlobContext(context.Background(), txBlob, failHard)
+}
 
-	return c.submitRequest(&requests.SubmitRequest{
-		TxBlob:   txBlob,
-		FailHard: failHard,
-	})
+// SubmitTxBlobContext is like SubmitTxBlob but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitTxBlobContext(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitResponse, error) {
+	return c.eng.SubmitTxBlob(ctx, txBlob, failHard)
 }
 
 // SubmitTxBlobAndWait sends a pre-signed transaction blob to the server,
@@ -136,6 +200,11 @@ func (c *Client) SubmitTxBlob(txBlob string, failHard bool) (*requests.SubmitRes
 // and then waits until the transaction is confirmed in a ledger. It returns
 // the transaction response if the submission is successful.
 func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.TxResponse, error) {
+	return c.SubmitTxBlobAndWaitContext(context.Background(), txBlob, failHard)
+}
+
+// SubmitTxBlobAndWaitContext is like SubmitTxBlobAndWait but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitTxBlobAndWaitContext(ctx context.Context, txBlob string, failHard bool) (*requests.TxResponse, error) {
 	tx, err := binarycodec.Decode(txBlob)
 	if err != nil {
 		return nil, err
@@ -143,12 +212,11 @@ func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.Tx
 
 	lastLedgerSequence, ok := tx["LastLedgerSequence"].(uint32)
 	if !ok {
-
 		return nil, ErrMissingLastLedgerSequenceInTransaction
-
 	}
 
-	txResponse, err := c.SubmitTxBlob(txBlob, failHard)
+	e := c.eng
+	txResponse, err := e.SubmitTxBlob(ctx, txBlob, failHard)
 	if err != nil {
 		return nil, err
 	}
@@ -162,25 +230,28 @@ func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.Tx
 		return nil, err
 	}
 
-	return c.waitForTransaction(txHash, lastLedgerSequence)
+	return e.WaitForTransaction(ctx, txHash, lastLedgerSequence)
 }
 
 // SubmitTx signs the transaction (if necessary) and submits it to the server
 // via a submission request. It applies the provided submit options to decide whether
 // to autofill missing fields and enforce failHard mode during submission.
 func (c *Client) SubmitTx(tx transaction.FlatTransaction, opts *rpctypes.SubmitOptions) (*requests.SubmitResponse, error) {
+	return c.SubmitTxContext(context.Background(), tx, opts)
+}
+
+// SubmitTxContext is like SubmitTx but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitTxContext(ctx context.Context, tx transaction.FlatTransaction, opts *rpctypes.SubmitOptions) (*requests.SubmitResponse, error) {
 	if opts == nil {
 		opts = &rpctypes.SubmitOptions{}
 	}
-	txBlob, err := c.getSignedTx(tx, opts.Autofill, opts.Wallet)
+	e := c.eng
+	txBlob, err := e.SignTx(ctx, tx, opts.Autofill, opts.Wallet)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.submitRequest(&requests.SubmitRequest{
-		TxBlob:   txBlob,
-		FailHard: opts.FailHard,
-	})
+	return e.SubmitTxBlob(ctx, txBlob, opts.FailHard)
 }
 
 // SubmitTxAndWait prepares a transaction by ensuring it is fully signed,
@@ -188,116 +259,100 @@ func (c *Client) SubmitTx(tx transaction.FlatTransaction, opts *rpctypes.SubmitO
 // It validates that the transaction's EngineResult is successful before returning
 // the transaction response.
 func (c *Client) SubmitTxAndWait(tx transaction.FlatTransaction, opts *rpctypes.SubmitOptions) (*requests.TxResponse, error) {
+	return c.SubmitTxAndWaitContext(context.Background(), tx, opts)
+}
+
+// SubmitTxAndWaitContext is like SubmitTxAndWait but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitTxAndWaitContext(ctx context.Context, tx transaction.FlatTransaction, opts *rpctypes.SubmitOptions) (*requests.TxResponse, error) {
 	if opts == nil {
 		opts = &rpctypes.SubmitOptions{}
 	}
 	// Get the signed transaction blob.
-	txBlob, err := c.getSignedTx(tx, opts.Autofill, opts.Wallet)
+	txBlob, err := c.eng.SignTx(ctx, tx, opts.Autofill, opts.Wallet)
 	if err != nil {
 		return nil, err
 	}
 
 	// Delegate to SubmitTxBlobAndWait to handle submission, engine result check,
 	// ledger sequence validation, and waiting for confirmation.
-	return c.SubmitTxBlobAndWait(txBlob, opts.FailHard)
+	return c.SubmitTxBlobAndWaitContext(ctx, txBlob, opts.FailHard)
 }
 
-// SubmitMultisigned submits a multisigned transaction blob to the server and returns the response.
-func (c *Client) SubmitMultisigned(txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
+// SubmitTxBlobReliable submits a pre-signed transaction blob, resubmitting it while its preliminary
+// result is retriable, and waits until its outcome is final: validated with tesSUCCESS or a tec result,
+// expired once the last validated ledger passes its LastLedgerSequence, or rejected by the server.
+// Unlike SubmitTxBlobAndWait, it isn't bounded by the configured max retries.
+func (c *Client) SubmitTxBlobReliable(txBlob string, failHard bool) (*xrpl.SubmissionResult, error) {
+	return c.SubmitTxBlobReliableContext(context.Background(), txBlob, failHard)
+}
+
+// SubmitTxBlobReliableContext is like SubmitTxBlobReliable but uses ctx to cancel the requests and propagate deadlines.
+func (c *Client) SubmitTxBlobReliableContext(ctx context.Context, txBlob string, failHard bool) (*xrpl.SubmissionResult, error) {
+	return c.eng.SubmitTxBlobReliable(ctx, txBlob, failHard)
+}
+
+// SubmitTxReliable signs the transaction (if necessary) and submits it like SubmitTxBlobReliable,
+// returning its final outcome.
+func (c *Client) SubmitTxReliable(tx transaction.FlatTransaction, opts *rpctypes.SubmitOptions) (*xrpl.SubmissionResult, error) {
+	return c.SubmitTxReliableContext(context.Background(), tx, opts)
+}
+
+// SubmitTxReliableContext is like SubmitTxReliable but uses ctx to cancel the requests and propagate deadlines.
+func (c *Client) SubmitTxReliableContext(ctx context.Context, tx transaction.FlatTransaction, opts *rpctypes.SubmitOptions) (*xrpl.SubmissionResult, error) {
+	if opts == nil {
+		opts = &rpctypes.SubmitOptions{}
+	}
+	txBlob, err := c.eng.SignTx(ctx, tx, opts.Autofill, opts.Wallet)
 	if err != nil {
 		return nil, err
 	}
-	signers, okSigners := tx["Signers"].([]interface{})
-
-	if okSigners && len(signers) > 0 {
-		for _, sig := range signers {
-			signer := sig.(map[string]any)
-			signerData := signer["Signer"].(map[string]any)
-			if signerData["SigningPubKey"] == "" && signerData["TxnSignature"] == "" {
-				return nil, ErrSignerDataIsEmpty
-			}
-		}
-	}
+	return c.SubmitTxBlobReliableContext(ctx, txBlob, opts.FailHard)
+}
 
-	return c.submitMultisignedRequest(&requests.SubmitMultisignedRequest{
-		Tx:       tx,
-		FailHard: failHard,
-	})
+// SubmitMultisigned submits a multisigned transaction blob to the server and returns the response.
+func (c *Client) SubmitMultisigned(txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
+	return c.SubmitMultisignedContext(context.Background(), txBlob, failHard)
+}
+
+// SubmitMultisignedContext is like SubmitMultisigned but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitMultisignedContext(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
+	return c.eng.SubmitMultisigned(ctx, txBlob, failHard)
+}
+
+// Simulate executes an unsigned transaction against the current open ledger without submitting it, and returns
+// its engine result and metadata. If autofill is true, the transaction is autofilled first, otherwise the server
+// fills its missing Fee, Sequence, SigningPubKey and NetworkID. Signed transactions are rejected with
+// transactions.ErrSimulateSignedTx. Use BalanceChanges on the response to preview the effect of the
+// transaction on balances.
+func (c *Client) Simulate(tx transaction.FlatTransaction, autofill bool) (*requests.SimulateResponse, error) {
+	return c.SimulateContext(context.Background(), tx, autofill)
+}
+
+// SimulateContext is like Simulate but uses ctx to cancel the requests and propagate deadlines.
+func (c *Client) SimulateContext(ctx context.Context, tx transaction.FlatTransaction, autofill bool) (*requests.SimulateResponse, error) {
+	return c.eng.Simulate(ctx, tx, autofill)
 }
 
 // Autofill fills in the missing fields in a transaction.
 func (c *Client) Autofill(tx *transaction.FlatTransaction) error {
-	if err := c.setValidTransactionAddresses(tx); err != nil {
-		return err
-	}
-
-	err := c.setTransactionFlags(tx)
-	if err != nil {
-		return err
-	}
+	return c.AutofillContext(context.Background(), tx)
+}
 
-	if _, ok := (*tx)["NetworkID"]; !ok {
-		if c.NetworkID != 0 {
-			(*tx)["NetworkID"] = c.NetworkID
-		}
-	}
-	if _, ok := (*tx)["Sequence"]; !ok {
-		err := c.setTransactionNextValidSequenceNumber(tx)
-		if err != nil {
-			return err
-		}
-	}
-	if _, ok := (*tx)["Fee"]; !ok {
-		err := c.calculateFeePerTransactionType(tx, 0)
-		if err != nil {
-			return err
-		}
-	}
-	if _, ok := (*tx)["LastLedgerSequence"]; !ok {
-		err := c.setLastLedgerSequence(tx)
-		if err != nil {
-			return err
-		}
-	}
-	if txType, ok := (*tx)["TransactionType"].(string); ok {
-		if acc, ok := (*tx)["Account"].(types.Address); txType == transaction.AccountDeleteTx.String() && ok {
-			err := c.checkAccountDeleteBlockers(acc)
-			if err != nil {
-				return err
-			}
-		}
-		if txType == transaction.PaymentTx.String() {
-			err := c.checkPaymentAmounts(tx)
-			if err != nil {
-				return err
-			}
-		}
-		if txType == transaction.BatchTx.String() {
-			err := c.autofillRawTransactions(tx)
-			if err != nil {
-				return err
-			}
-		}
-	}
-	return nil
+// AutofillContext is like Autofill but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) AutofillContext(ctx context.Context, tx *transaction.FlatTransaction) error {
+	return c.eng.Autofill(ctx, tx)
 }
 
 // AutofillMultisigned fills in the missing fields in a multisigned transaction.
 // This function is used to fill in the missing fields in a multisigned transaction.
 // It fills in the missing fields in the transaction and calculates the fee per number of signers.
 func (c *Client) AutofillMultisigned(tx *transaction.FlatTransaction, nSigners uint64) error {
-	err := c.Autofill(tx)
-	if err != nil {
-		return err
-	}
-
-	err = c.calculateFeePerTransactionType(tx, nSigners)
-	if err != nil {
-		return err
-	}
+	return c.AutofillMultisignedContext(context.Background(), tx, nSigners)
+}
 
-	return nil
+// AutofillMultisignedContext is like AutofillMultisigned but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) AutofillMultisignedContext(ctx context.Context, tx *transaction.FlatTransaction, nSigners uint64) error {
+	return c.eng.AutofillMultisigned(ctx, tx, nSigners)
 }
 
 // FaucetProvider returns the faucet provider for the client.
@@ -318,82 +373,3 @@ func (c *Client) FundWallet(wallet *wallet.Wallet) error {
 
 	return nil
 }
-
-func (c *Client) autofillRawTransactions(tx *transaction.FlatTransaction) error {
-	needsNetworkID, err := c.txNeedsNetworkID()
-	if err != nil {
-		return err
-	}
-
-	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return ErrRawTransactionsFieldIsNotAnArray
-	}
-
-	accountSeq := make(map[string]uint32, len(rawTxs))
-
-	for _, rawTx := range rawTxs {
-		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return ErrRawTransactionFieldIsNotAnObject
-		}
-
-		// Validate `Fee` field
-		if innerRawTx["Fee"] == nil {
-			innerRawTx["Fee"] = "0"
-		} else if innerRawTx["Fee"] != "0" {
-			return types.ErrBatchInnerTransactionInvalid
-		}
-
-		// Validate `SigningPubKey` field
-		if innerRawTx["SigningPubKey"] == nil {
-			innerRawTx["SigningPubKey"] = ""
-		} else if innerRawTx["SigningPubKey"] != "" {
-			return ErrSigningPubKeyFieldMustBeEmpty
-		}
-
-		// Validate `TxnSignature` field
-		if innerRawTx["TxnSignature"] != nil {
-			return ErrTxnSignatureFieldMustBeEmpty
-		}
-		if innerRawTx["Signers"] != nil {
-			return ErrSignersFieldMustBeEmpty
-		}
-
-		// Validate `NetworkID` field
-		if innerRawTx["NetworkID"] == nil && needsNetworkID {
-			innerRawTx["NetworkID"] = c.NetworkID
-		}
-
-		// Validate `Sequence` field
-		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
-
-			acc, ok := innerRawTx["Account"].(string)
-			if !ok {
-				return ErrAccountFieldIsNotAString
-			}
-
-			if accountSeq[acc] != 0 {
-				innerRawTx["Sequence"] = accountSeq[acc]
-				accountSeq[acc]++
-			} else {
-				accountInfo, err := c.GetAccountInfo(&account.InfoRequest{
-					Account: types.Address(acc),
-				})
-				if err != nil {
-					return err
-				}
-				var seq uint32
-				if innerRawTx["Account"] == (*tx)["Account"] {
-					seq = accountInfo.AccountData.Sequence + 1
-				} else {
-					seq = accountInfo.AccountData.Sequence
-				}
-				accountSeq[acc] = seq + 1
-				innerRawTx["Sequence"] = seq
-			}
-		}
-	}
-
-	return nil
-}
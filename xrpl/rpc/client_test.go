@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -8,8 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Peersyst/xrpl-go/xrpl"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
 	rpctypes "github.com/Peersyst/xrpl-go/xrpl/rpc/types"
@@ -27,7 +30,8 @@ func TestClient(t *testing.T) {
 
 		jsonRpcClient := NewClient(cfg)
 
-		assert.Equal(t, &Client{cfg: cfg}, jsonRpcClient)
+		assert.Equal(t, cfg, jsonRpcClient.cfg)
+		assert.NotNil(t, jsonRpcClient.eng)
 	})
 }
 
@@ -279,6 +283,32 @@ func TestClient_Request(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "timeout")
 	})
+
+	t.Run("SendRequest - cancelled context", func(t *testing.T) {
+		req := &account.ChannelsRequest{
+			Account: "rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu",
+		}
+
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+			mc.RequestCount++
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc))
+		assert.NoError(t, err)
+
+		jsonRpcClient := NewClient(cfg)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err = jsonRpcClient.RequestContext(ctx, req)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, mc.RequestCount)
+	})
 }
 
 func TestClient_SubmitTxBlob(t *testing.T) {
@@ -810,7 +840,7 @@ func TestClient_autofillRawTransactions(t *testing.T) {
 			},
 			networkID:   0,
 			expectedTx:  transaction.FlatTransaction{},
-			expectedErr: &ClientError{ErrorString: "actNotFound"},
+			expectedErr: &xrpl.XRPLError{Token: "actNotFound"},
 		},
 	}
 
@@ -827,19 +857,15 @@ func TestClient_autofillRawTransactions(t *testing.T) {
 				originalTx[k] = v
 			}
 
-			err := cl.autofillRawTransactions(&tt.tx)
+			err := cl.eng.AutofillRawTransactions(context.Background(), &tt.tx)
 
 			if tt.expectedErr != nil {
 				require.Error(t, err)
 
 				// Check error type and message
 				switch expectedErr := tt.expectedErr.(type) {
-				case *ClientError:
-					if clientErr, ok := err.(*ClientError); ok {
-						require.Equal(t, expectedErr.ErrorString, clientErr.ErrorString)
-					} else {
-						t.Errorf("Expected ClientError, but got %T", err)
-					}
+				case *xrpl.XRPLError:
+					require.ErrorIs(t, err, expectedErr)
 				default:
 					require.Equal(t, tt.expectedErr.Error(), err.Error())
 				}
@@ -887,3 +913,102 @@ func setupTestRPCClientForAutofill(t *testing.T, mockResponses []string) *Client
 
 	return NewClient(cfg)
 }
+
+func TestClient_LoadFactorThrottling(t *testing.T) {
+	tests := []struct {
+		name         string
+		throttling   bool
+		expectedRate float64
+	}{
+		{
+			name:         "pass - rate adapts to the load factor",
+			throttling:   true,
+			expectedRate: 25,
+		},
+		{
+			name:         "pass - rate is fixed without throttling",
+			throttling:   false,
+			expectedRate: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(`{"result": {"info": {"load_factor": 4}, "status": "success"}}`, 200, mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithRateLimit(100, 10), WithLoadFactorThrottling(tt.throttling))
+			require.NoError(t, err)
+
+			_, err = NewClient(cfg).GetServerInfo(&server.InfoRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRate, cfg.rateLimiter.Rate())
+		})
+	}
+}
+
+func TestClient_Interceptors(t *testing.T) {
+	tests := []struct {
+		name            string
+		response        string
+		expectedErr     error
+		expectedPayload string
+	}{
+		{
+			name:            "pass - response",
+			response:        `{"result": {"info": {"build_version": "2.3.0"}, "status": "success"}}`,
+			expectedPayload: `{"result": {"info": {"build_version": "2.3.0"}, "status": "success"}}`,
+		},
+		{
+			name:            "fail - error response",
+			response:        `{"result": {"error": "tooBusy", "status": "error"}}`,
+			expectedErr:     xrpl.ErrTooBusy,
+			expectedPayload: `{"result": {"error": "tooBusy", "status": "error"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []*xrpl.RequestEvent
+			var responses, failures []*xrpl.ResponseEvent
+
+			mc := &testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.response, 200, mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithRetryPolicy(RetryPolicy{}), WithInterceptors(xrpl.Interceptor{
+				BeforeRequest: func(ctx context.Context, req *xrpl.RequestEvent) context.Context {
+					requests = append(requests, req)
+					return ctx
+				},
+				AfterResponse: func(_ context.Context, res *xrpl.ResponseEvent) {
+					responses = append(responses, res)
+				},
+				OnError: func(_ context.Context, res *xrpl.ResponseEvent) {
+					failures = append(failures, res)
+				},
+			}))
+			require.NoError(t, err)
+
+			_, err = NewClient(cfg).GetServerInfo(&server.InfoRequest{})
+
+			require.Len(t, requests, 1)
+			require.Equal(t, "server_info", requests[0].Method)
+			require.Equal(t, uint64(1), requests[0].ID)
+			require.JSONEq(t, `{"method": "server_info", "params": [{"api_version": 2}]}`, string(requests[0].Payload))
+
+			events := responses
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Empty(t, responses)
+				events = failures
+			} else {
+				require.NoError(t, err)
+				require.Empty(t, failures)
+			}
+			require.Len(t, events, 1)
+			require.Same(t, requests[0], events[0].Request)
+			require.Equal(t, tt.expectedPayload, string(events[0].Payload))
+			require.ErrorIs(t, events[0].Err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/clio"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetNFTInfo(t *testing.T) {
+	tests := []struct {
+		name            string
+		serverInfo      string
+		expectedMethods []string
+		expectedErr     error
+	}{
+		{
+			name:            "pass - Clio server",
+			serverInfo:      `{"result": {"info": {"clio_version": "2.3.0", "complete_ledgers": "32570-6595042"}, "validated": true}}`,
+			expectedMethods: []string{"server_info", "nft_info", "nft_info"},
+		},
+		{
+			name:            "fail - rippled server",
+			serverInfo:      `{"result": {"info": {"build_version": "2.3.0", "complete_ledgers": "32570-6595042"}}}`,
+			expectedMethods: []string{"server_info"},
+			expectedErr:     ErrClioRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var methods []string
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+				var body struct {
+					Method string `json:"method"`
+				}
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					return nil, err
+				}
+				methods = append(methods, body.Method)
+				response := tt.serverInfo
+				if body.Method == "nft_info" {
+					response = `{"result": {"nft_id": "00080000B4F4AFC5FBCBD76873F18006173D2193467D3EE70000099B00000000", "ledger_index": 270, "owner": "rG9gdNygQ6npA9JvDFWBoeXbiUcTYJnEnk", "is_burned": false, "flags": 8, "issuer": "rHVokeuSnjPjz718qdb47bGXBBHNMP3KDQ", "validated": true}}`
+				}
+				return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(response)))}, nil
+			}
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+			client := NewClient(cfg)
+
+			req := &clio.NFTInfoRequest{NFTokenID: "00080000B4F4AFC5FBCBD76873F18006173D2193467D3EE70000099B00000000"}
+			res, err := client.GetNFTInfo(req)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Equal(t, tt.expectedMethods, methods)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "rG9gdNygQ6npA9JvDFWBoeXbiUcTYJnEnk", res.Owner.String())
+
+			// The server type is only detected once.
+			_, err = client.GetNFTInfo(req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedMethods, methods)
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package rpc
 
 import (
+	"log/slog"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/engine"
+	"github.com/Peersyst/xrpl-go/xrpl/ratelimit"
 )
 
 // HTTPClient defines the interface for sending HTTP requests.
@@ -20,13 +24,32 @@ type Config struct {
 	Headers    map[string][]string
 
 	// Retry config
-	maxRetries int
-	retryDelay time.Duration
+	maxRetries        int
+	retryDelay        time.Duration
+	retryPolicy       *RetryPolicy
+	maxRequestRetries *int
+
+	// Rate limit config
+	rateLimiter          *ratelimit.Limiter
+	loadFactorThrottling bool
+
+	// Interceptors notified of every request
+	interceptors xrpl.Interceptors
+
+	// Logger of retries, fee calculations and autofill decisions
+	logger *slog.Logger
+
+	// Batch config
+	jsonRPCBatch     bool
+	batchConcurrency int
 
 	// Fee config
 	maxFeeXRP  float32
 	feeCushion float32
 
+	// Sequence config
+	sequences *engine.SequenceManager
+
 	// Faucet config
 	faucetProvider common.FaucetProvider
 
@@ -43,20 +66,87 @@ func WithHTTPClient(cl HTTPClient) ConfigOpt {
 	}
 }
 
-// WithMaxRetries returns a ConfigOpt that sets the maximum number of retries.
+// WithMaxRetries returns a ConfigOpt that sets the maximum number of times a transaction is looked up
+// while waiting for it. Use WithMaxRequestRetries or WithRetryPolicy to configure the retries of failed requests.
 func WithMaxRetries(maxRetries int) ConfigOpt {
 	return func(c *Config) {
 		c.maxRetries = maxRetries
 	}
 }
 
-// WithRetryDelay returns a ConfigOpt that sets the delay between retry attempts.
+// WithRetryDelay returns a ConfigOpt that sets the delay between two lookups of a transaction while waiting for it.
 func WithRetryDelay(retryDelay time.Duration) ConfigOpt {
 	return func(c *Config) {
 		c.retryDelay = retryDelay
 	}
 }
 
+// WithRetryPolicy returns a ConfigOpt that sets the policy used to retry failed requests.
+// It replaces DefaultRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) ConfigOpt {
+	return func(c *Config) {
+		c.retryPolicy = &policy
+	}
+}
+
+// WithMaxRequestRetries returns a ConfigOpt that sets the maximum number of retries of a failed request,
+// overriding the MaxRetries of the retry policy. Zero disables retries.
+func WithMaxRequestRetries(maxRetries int) ConfigOpt {
+	return func(c *Config) {
+		c.maxRequestRetries = &maxRetries
+	}
+}
+
+// WithRateLimit returns a ConfigOpt that limits the client to rate requests per second, with bursts of
+// up to burst requests. Requests wait until they are allowed to be sent.
+func WithRateLimit(rate float64, burst int) ConfigOpt {
+	return func(c *Config) {
+		c.rateLimiter = ratelimit.New(rate, burst)
+	}
+}
+
+// WithLoadFactorThrottling returns a ConfigOpt that sets whether the rate limit adapts to the load of the
+// server. The rate is divided by the load_factor of every server_info response.
+// It has no effect without WithRateLimit.
+func WithLoadFactorThrottling(enabled bool) ConfigOpt {
+	return func(c *Config) {
+		c.loadFactorThrottling = enabled
+	}
+}
+
+// WithInterceptors returns a ConfigOpt that adds interceptors notified before every request is sent and
+// after its response or error is received, for example for logging, tracing or metrics.
+func WithInterceptors(interceptors ...xrpl.Interceptor) ConfigOpt {
+	return func(c *Config) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// WithLogger returns a ConfigOpt that sets the logger receiving structured records of the retries,
+// fee calculations and autofill decisions of the client. Nothing is logged by default.
+func WithLogger(logger *slog.Logger) ConfigOpt {
+	return func(c *Config) {
+		c.logger = logger
+	}
+}
+
+// WithJSONRPCBatch returns a ConfigOpt that sets whether BatchRequest sends its requests as a single
+// JSON-RPC batch, a request of the batch method listing them all. Servers that reject it are sent the
+// requests one by one instead.
+func WithJSONRPCBatch(enabled bool) ConfigOpt {
+	return func(c *Config) {
+		c.jsonRPCBatch = enabled
+	}
+}
+
+// WithBatchConcurrency returns a ConfigOpt that sets the number of requests of a batch sent concurrently
+// when they aren't sent as a JSON-RPC batch.
+func WithBatchConcurrency(concurrency int) ConfigOpt {
+	return func(c *Config) {
+		c.batchConcurrency = concurrency
+	}
+}
+
 // WithMaxFeeXRP returns a ConfigOpt that sets the maximum fee in XRP.
 func WithMaxFeeXRP(maxFeeXRP float32) ConfigOpt {
 	return func(c *Config) {
@@ -71,6 +161,15 @@ func WithFeeCushion(feeCushion float32) ConfigOpt {
 	}
 }
 
+// WithSequenceManager returns a ConfigOpt that sets the sequence manager handing out the Sequence of
+// autofilled transactions, instead of fetching it with account_info for every transaction.
+// The same manager can be shared by several clients.
+func WithSequenceManager(m *engine.SequenceManager) ConfigOpt {
+	return func(c *Config) {
+		c.sequences = m
+	}
+}
+
 // WithFaucetProvider returns a ConfigOpt that sets the faucet provider.
 func WithFaucetProvider(fp common.FaucetProvider) ConfigOpt {
 	return func(c *Config) {
@@ -79,6 +178,7 @@ func WithFaucetProvider(fp common.FaucetProvider) ConfigOpt {
 }
 
 // WithTimeout returns a ConfigOpt that sets the request timeout for the HTTP client.
+// It applies to every attempt of a request, not to its retries.
 func WithTimeout(timeout time.Duration) ConfigOpt {
 	return func(c *Config) {
 		c.timeout = timeout
@@ -110,6 +210,8 @@ func NewClientConfig(url string, opts ...ConfigOpt) (*Config, error) {
 		maxRetries: common.DefaultMaxRetries,
 		retryDelay: common.DefaultRetryDelay,
 
+		batchConcurrency: common.DefaultBatchConcurrency,
+
 		maxFeeXRP:  common.DefaultMaxFeeXRP,
 		feeCushion: common.DefaultFeeCushion,
 	}
@@ -118,6 +220,16 @@ func NewClientConfig(url string, opts ...ConfigOpt) (*Config, error) {
 		opt(cfg)
 	}
 
+	if cfg.retryPolicy == nil {
+		policy := DefaultRetryPolicy()
+		cfg.retryPolicy = &policy
+	}
+	if cfg.maxRequestRetries != nil {
+		policy := *cfg.retryPolicy
+		policy.MaxRetries = *cfg.maxRequestRetries
+		cfg.retryPolicy = &policy
+	}
+
 	// Ensure the HTTPClient has the correct timeout if user did not set one
 	if hc, ok := cfg.HTTPClient.(*http.Client); ok && cfg.timeout == 0 {
 		hc.Timeout = common.DefaultTimeout
@@ -125,3 +237,14 @@ func NewClientConfig(url string, opts ...ConfigOpt) (*Config, error) {
 
 	return cfg, nil
 }
+
+// discardLogger is used when no logger is configured.
+var discardLogger = slog.New(slog.DiscardHandler)
+
+// log returns the configured logger, or a logger discarding every record if none is set.
+func (c *Config) log() *slog.Logger {
+	if c.logger == nil {
+		return discardLogger
+	}
+	return c.logger
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/engine"
 	"github.com/Peersyst/xrpl-go/xrpl/faucet"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -53,7 +54,8 @@ func TestConfigCreation(t *testing.T) {
 			"Content-Type": {"application/json"},
 		}
 		req.Header = cfg.Headers
-		assert.Equal(t, &Config{HTTPClient: customHttpClient{}, URL: "http://s1.ripple.com:51234/", Headers: headers, maxRetries: common.DefaultMaxRetries, retryDelay: common.DefaultRetryDelay, feeCushion: common.DefaultFeeCushion, maxFeeXRP: common.DefaultMaxFeeXRP, faucetProvider: nil}, cfg)
+		retryPolicy := DefaultRetryPolicy()
+		assert.Equal(t, &Config{HTTPClient: customHttpClient{}, URL: "http://s1.ripple.com:51234/", Headers: headers, maxRetries: common.DefaultMaxRetries, retryDelay: common.DefaultRetryDelay, retryPolicy: &retryPolicy, batchConcurrency: common.DefaultBatchConcurrency, feeCushion: common.DefaultFeeCushion, maxFeeXRP: common.DefaultMaxFeeXRP, faucetProvider: nil}, cfg)
 		assert.NoError(t, err)
 	})
 }
@@ -91,6 +93,22 @@ func TestWithMaxRetries(t *testing.T) {
 	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithMaxRetries(maxRetries))
 
 	require.Equal(t, maxRetries, cfg.maxRetries)
+	// The retries of failed requests are configured separately.
+	require.Equal(t, common.DefaultMaxRequestRetries, cfg.retryPolicy.MaxRetries)
+}
+
+func TestWithMaxRequestRetries(t *testing.T) {
+	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithMaxRequestRetries(5))
+
+	require.Equal(t, 5, cfg.retryPolicy.MaxRetries)
+	require.Equal(t, common.DefaultMaxRetries, cfg.maxRetries)
+
+	policy := RetryPolicy{MaxRetries: 2, RetryableStatusCodes: []int{http.StatusBadGateway}}
+	cfg, _ = NewClientConfig("http://s1.ripple.com:51234", WithMaxRequestRetries(0), WithRetryPolicy(policy))
+
+	require.Equal(t, 0, cfg.retryPolicy.MaxRetries)
+	require.Equal(t, []int{http.StatusBadGateway}, cfg.retryPolicy.RetryableStatusCodes)
+	require.Equal(t, 2, policy.MaxRetries)
 }
 
 func TestWithRetryDelay(t *testing.T) {
@@ -98,4 +116,28 @@ func TestWithRetryDelay(t *testing.T) {
 	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithRetryDelay(retryDelay))
 
 	require.Equal(t, retryDelay, cfg.retryDelay)
+	require.Equal(t, common.DefaultRetryDelay, cfg.retryPolicy.BaseDelay)
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond, RetryableStatusCodes: []int{http.StatusBadGateway}}
+	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithRetryPolicy(policy), WithMaxRetries(5))
+
+	require.Equal(t, &policy, cfg.retryPolicy)
+	require.Equal(t, 5, cfg.maxRetries)
+}
+
+func TestWithRateLimit(t *testing.T) {
+	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithRateLimit(10, 5), WithLoadFactorThrottling(true))
+
+	require.NotNil(t, cfg.rateLimiter)
+	require.Equal(t, float64(10), cfg.rateLimiter.Rate())
+	require.True(t, cfg.loadFactorThrottling)
+}
+
+func TestWithSequenceManager(t *testing.T) {
+	m := engine.NewSequenceManager()
+	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithSequenceManager(m))
+
+	require.Same(t, m, cfg.sequences)
 }
@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/Peersyst/xrpl-go/xrpl/engine"
+)
+
+// executor sends the requests of the transaction engine through the client.
+type executor struct {
+	c *Client
+}
+
+// Execute implements the engine.Executor interface.
+func (e executor) Execute(ctx context.Context, req engine.Request, result any) error {
+	res, err := e.c.RequestContext(ctx, req)
+	if err != nil {
+		return err
+	}
+	return res.GetResult(result)
+}
+
+// newEngine creates the transaction engine used to autofill, submit and wait for transactions,
+// configured with the settings of the client.
+func newEngine(c *Client) *engine.Engine {
+	return engine.New(executor{c: c}, engine.Config{
+		FeeCushion: c.cfg.feeCushion,
+		MaxFeeXRP:  c.cfg.maxFeeXRP,
+		MaxRetries: c.cfg.maxRetries,
+		RetryDelay: c.cfg.retryDelay,
+		NetworkID:  func() uint32 { return c.NetworkID },
+		Sequences:  c.cfg.sequences,
+		Clio:       &c.clio,
+		Logger:     c.cfg.log(),
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/Peersyst/xrpl-go/xrpl"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
@@ -104,8 +105,41 @@ func TestCheckForError(t *testing.T) {
 
 		bodyBytes, err := checkForError(res)
 		assert.NotNil(t, bodyBytes)
-		expError := &ClientError{ErrorString: "ledgerIndexMalformed"}
+		expError := &xrpl.XRPLError{
+			Token:  "ledgerIndexMalformed",
+			Status: "error",
+			Request: map[string]any{
+				"account":      "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
+				"command":      "account_info",
+				"ledger_index": "-",
+				"strict":       true,
+			},
+		}
+		assert.Equal(t, expError, err)
+	})
+
+	t.Run("Error Response with error code and message", func(t *testing.T) {
+
+		json := `{
+			"result": {
+				"error": "actNotFound",
+				"error_code": 19,
+				"error_message": "Account not found.",
+				"status": "error"
+			}
+		}`
+
+		b := io.NopCloser(bytes.NewReader([]byte(json)))
+		res := &http.Response{
+			StatusCode: 200,
+			Body:       b,
+		}
+
+		_, err := checkForError(res)
+		expError := &xrpl.XRPLError{Token: "actNotFound", Code: 19, Message: "Account not found.", Status: "error"}
 		assert.Equal(t, expError, err)
+		assert.ErrorIs(t, err, xrpl.ErrActNotFound)
+		assert.EqualError(t, err, "actNotFound: Account not found.")
 	})
 
 	t.Run("Error Response with error code", func(t *testing.T) {
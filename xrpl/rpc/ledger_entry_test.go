@@ -0,0 +1,291 @@
+package rpc
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    string
+		expectedErr error
+	}{
+		{
+			name:     "pass - check",
+			response: `{"result": {"index": "49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0", "validated": true, "node": {"LedgerEntryType": "Check", "Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "SendMax": "100000000", "Sequence": 2}}}`,
+		},
+		{
+			name:        "fail - not a check",
+			response:    `{"result": {"index": "49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0", "validated": true, "node": {"LedgerEntryType": "Ticket"}}}`,
+			expectedErr: ErrUnexpectedLedgerEntryType{Expected: "Check", Actual: "Ticket"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+				b, err := io.ReadAll(req.Body)
+				if err != nil {
+					return nil, err
+				}
+				body = string(b)
+				return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(tt.response)))}, nil
+			}
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+			client := NewClient(cfg)
+
+			check, err := client.GetCheck(&ledger.EntryRequest{Check: "49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0"})
+			require.Contains(t, body, `"check":"49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0"`)
+			if tt.expectedErr != nil {
+				require.Equal(t, tt.expectedErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", check.Destination.String())
+			require.Equal(t, uint32(2), check.Sequence)
+		})
+	}
+}
+
+func TestClient_LedgerEntryGetters(t *testing.T) {
+	tests := []struct {
+		name  string
+		node  string
+		get   func(c *Client, req *ledger.EntryRequest) (any, error)
+		check func(t *testing.T, entry any)
+	}{
+		{
+			name: "pass - account root",
+			node: `{"Account": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "AccountTxnID": "0D5FB50FA65C9FE1538FD7E398FFFE9D1908DFA4576D8D7A020040686F93C77D", "Balance": "148446663", "Domain": "6D64756F31332E636F6D", "EmailHash": "98B4375E1D753E5B91627516F6D70977", "Flags": 8388608, "LedgerEntryType": "AccountRoot", "MessageKey": "0000000000000000000000070000000300", "OwnerCount": 3, "PreviousTxnID": "0D5FB50FA65C9FE1538FD7E398FFFE9D1908DFA4576D8D7A020040686F93C77D", "PreviousTxnLgrSeq": 14091160, "Sequence": 336, "TransferRate": 1004999999, "index": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetAccountRoot(req)
+			},
+			check: func(t *testing.T, entry any) {
+				accountRoot := entry.(*ledgerentry.AccountRoot)
+				require.Equal(t, types.XRPCurrencyAmount(148446663), accountRoot.Balance)
+				require.Equal(t, uint32(336), accountRoot.Sequence)
+			},
+		},
+		{
+			name: "pass - ripple state",
+			node: `{"Balance": {"currency": "USD", "issuer": "rrrrrrrrrrrrrrrrrrrrBZbvji", "value": "-10"}, "Flags": 393216, "HighLimit": {"currency": "USD", "issuer": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "value": "110"}, "HighNode": "0000000000000000", "LedgerEntryType": "RippleState", "LowLimit": {"currency": "USD", "issuer": "rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW", "value": "0"}, "LowNode": "0000000000000000", "PreviousTxnID": "E3FE6EA3D48F0C2B639448020EA4F03D4F4F8FFDB243A852A0F59177921B4879", "PreviousTxnLgrSeq": 14090896, "index": "9CA88CDEDFF9252B3DE183CE35B038F57282BC9503CDFA1923EF9A95DF0D6F7B"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetRippleState(req)
+			},
+			check: func(t *testing.T, entry any) {
+				rippleState := entry.(*ledgerentry.RippleState)
+				require.Equal(t, "-10", rippleState.Balance.Value)
+				require.Equal(t, "110", rippleState.HighLimit.Value)
+			},
+		},
+		{
+			name: "pass - offer",
+			node: `{"Account": "rBqb89MRQJnMPq8wTwEbtz4kvxrEDfcYvt", "BookDirectory": "ACC27DE91DBA86FC509069EAF4BC511D73128B780F2E54BF5E07A369E2446000", "BookNode": "0000000000000000", "Flags": 131072, "LedgerEntryType": "Offer", "OwnerNode": "0000000000000000", "PreviousTxnID": "F0AB71E777B2DA54B86231E19B82554EF1F8211F92ECA473121C655BFC5329BF", "PreviousTxnLgrSeq": 14524914, "Sequence": 866, "TakerGets": {"currency": "XAG", "issuer": "r9Dr5xwkeLegBeXq6ujinjSBLQzQ1zQGjH", "value": "37"}, "TakerPays": "79550000000", "index": "96F76F27D8A327FC48753167EC04A46AA0E382E6F57F32FD12274144D00F1797"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetOffer(req)
+			},
+			check: func(t *testing.T, entry any) {
+				offer := entry.(*ledgerentry.Offer)
+				require.Equal(t, types.XRPCurrencyAmount(79550000000), offer.TakerPays)
+				require.Equal(t, types.IssuedCurrencyAmount{Currency: "XAG", Issuer: "r9Dr5xwkeLegBeXq6ujinjSBLQzQ1zQGjH", Value: "37"}, offer.TakerGets)
+			},
+		},
+		{
+			name: "pass - escrow",
+			node: `{"Account": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "Amount": {"currency": "USD", "issuer": "rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW", "value": "10"}, "CancelAfter": 545440232, "Condition": "A0258020A82A88B2DF843A54F58772E4A3861866ECDB4157645DD9AE528C1D3AEEDABAB6810120", "Destination": "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX", "DestinationNode": "0", "DestinationTag": 23480, "FinishAfter": 545354132, "Flags": 0, "IssuerNode": "1a", "LedgerEntryType": "Escrow", "OwnerNode": "0", "PreviousTxnID": "C44F2EB84196B9AD820313DBEBA6316A15C9A2D35787579ED172B87A30131DA7", "PreviousTxnLgrSeq": 28991004, "SourceTag": 11747, "index": "DC5F3851D8A1AB622F957761E5963BC5BD439D5C24AC6AD7AC4523F0640244AC"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetEscrow(req)
+			},
+			check: func(t *testing.T, entry any) {
+				escrow := entry.(*ledgerentry.Escrow)
+				require.Equal(t, types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW", Value: "10"}, escrow.Amount)
+				require.Equal(t, uint64(0x1a), escrow.IssuerNode)
+			},
+		},
+		{
+			name: "pass - payment channel",
+			node: `{"Account": "rBqb89MRQJnMPq8wTwEbtz4kvxrEDfcYvt", "Amount": "4325800", "Balance": "2323423", "CancelAfter": 536891313, "Destination": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "DestinationNode": "0", "DestinationTag": 1002341, "Expiration": 536027313, "Flags": 0, "LedgerEntryType": "PayChannel", "OwnerNode": "0", "PreviousTxnID": "F0AB71E777B2DA54B86231E19B82554EF1F8211F92ECA473121C655BFC5329BF", "PreviousTxnLgrSeq": 14524914, "PublicKey": "32D2471DB72B27E3310F355BB33E339BF26F8392D5A93D3BC0FC3B566612DA0F0A", "SettleDelay": 3600, "index": "96F76F27D8A327FC48753167EC04A46AA0E382E6F57F32FD12274144D00F1797"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetPaymentChannel(req)
+			},
+			check: func(t *testing.T, entry any) {
+				channel := entry.(*ledgerentry.PayChannel)
+				require.Equal(t, types.XRPCurrencyAmount(4325800), channel.Amount)
+				require.Equal(t, types.XRPCurrencyAmount(2323423), channel.Balance)
+			},
+		},
+		{
+			name: "pass - check",
+			node: `{"Account": "rUn84CUYbNjRoTQ6mSW7BVJPSVJNLb1QLo", "Destination": "rfkE1aSy9G8Upk4JssnwBxhEv5p4mn2KTy", "DestinationNode": "0", "DestinationTag": 1, "Expiration": 570113521, "Flags": 0, "InvoiceID": "46060241FABCF692D4D934BA2A6C4427CD4279083E38C77CBE642243E43BE291", "LedgerEntryType": "Check", "OwnerNode": "0", "PreviousTxnID": "5463C6E08862A1FAE5EDAC12D70ADB16546A1F674930521295BC082494B62924", "PreviousTxnLgrSeq": 6, "SendMax": "100000000", "Sequence": 2, "index": "49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetCheck(req)
+			},
+			check: func(t *testing.T, entry any) {
+				require.Equal(t, types.XRPCurrencyAmount(100000000), entry.(*ledgerentry.Check).SendMax)
+			},
+		},
+		{
+			name: "pass - ticket",
+			node: `{"Account": "rEhxGqkqPPSxQ3P25J66ft5TwpzV14k2de", "Flags": 0, "LedgerEntryType": "Ticket", "OwnerNode": "0", "PreviousTxnID": "F19AD4577212D3BEACA0F75FE1BA1644F2E854D46E8D62E9C95D18E9708CBFB1", "PreviousTxnLgrSeq": 4, "TicketSequence": 3, "index": "0FD3A8C0B3D9C3D15F43F4A67FDD4A79BC7E2B7B2AF8A4F2E6E6A47A12E4B0B5"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetTicket(req)
+			},
+			check: func(t *testing.T, entry any) {
+				require.Equal(t, uint32(3), entry.(*ledgerentry.Ticket).TicketSequence)
+			},
+		},
+		{
+			name: "pass - NFT page",
+			node: `{"Flags": 0, "LedgerEntryType": "NFTokenPage", "NFTokens": [{"NFToken": {"NFTokenID": "000B013A95F14B0044F78A264E41713C64B5F89242540EE208C3098E00000D65", "URI": "697066733A2F2F62616679626569676479727A74357366703775646D37687537367568377932366E6634646675796C71616266336F636C67747179353566627A6469"}}], "PreviousTxnID": "95C8761B22894E328646F7A70035E9FFBF5A6D9A3B4B04ACF2D26C07A4E9813D", "PreviousTxnLgrSeq": 42891441, "index": "95F14B0044F78A264E41713C64B5F89242540EE2FFFFFFFFFFFFFFFFFFFFFFFF"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetNFTPage(req)
+			},
+			check: func(t *testing.T, entry any) {
+				page := entry.(*ledgerentry.NFTokenPage)
+				require.Len(t, page.NFTokens, 1)
+				require.Equal(t, types.NFTokenID("000B013A95F14B0044F78A264E41713C64B5F89242540EE208C3098E00000D65"), page.NFTokens[0].NFTokenID)
+			},
+		},
+		{
+			name: "pass - AMM",
+			node: `{"Account": "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S", "Asset": {"currency": "XRP"}, "Asset2": {"currency": "TST", "issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"}, "AuctionSlot": {"Account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm", "AuthAccounts": [{"AuthAccount": {"Account": "rMKXGCbJ5d8LbrqthdG46q3f969MVK2Qeg"}}], "DiscountedFee": 60, "Expiration": 721870180, "Price": {"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", "issuer": "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S", "value": "0.8696263565463045"}}, "Flags": 0, "LPTokenBalance": {"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", "issuer": "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S", "value": "71150.53584131501"}, "LedgerEntryType": "AMM", "OwnerNode": "0", "PreviousTxnID": "26F52AD68480EAB7ADF19C2CCCE3A0329AEF8CF9CB46329031BD16C6200BCD8D", "PreviousTxnLgrSeq": 40, "TradingFee": 600, "VoteSlots": [{"VoteEntry": {"Account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm", "TradingFee": 600, "VoteWeight": 100000}}], "index": "D21A7DB2AF9F0C3DDCA9D8E1F3BE4A4B5E4E6C7D5F8A5B3C2D1E0F9A8B7C6D5E"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetAMM(req)
+			},
+			check: func(t *testing.T, entry any) {
+				amm := entry.(*ledgerentry.AMM)
+				require.Equal(t, "TST", amm.Asset2.Currency)
+				require.Equal(t, "71150.53584131501", amm.LPTokenBalance.(types.IssuedCurrencyAmount).Value)
+				require.Equal(t, uint16(600), amm.TradingFee)
+			},
+		},
+		{
+			name: "pass - DID",
+			node: `{"Account": "rpfqJrXg5uidNo2ZsRhRY6TiF1cvYmV9Fg", "DIDDocument": "646F63", "Data": "617474657374", "Flags": 0, "LedgerEntryType": "DID", "OwnerNode": "0", "PreviousTxnID": "A4C15DA185E6092DF5954FF62A1446220C61A5F60F0D93B4B09F708778E41120", "PreviousTxnLgrSeq": 4, "URI": "6469645F6578616D706C65", "index": "46813BE38B798B3752CA590D44E7FEADB17485649074403AD1761A2835CE91FF"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetDID(req)
+			},
+			check: func(t *testing.T, entry any) {
+				require.Equal(t, "646F63", entry.(*ledgerentry.DID).DIDDocument)
+			},
+		},
+		{
+			name: "pass - oracle",
+			node: `{"AssetClass": "63757272656E6379", "Flags": 0, "LastUpdateTime": 1724871860, "LedgerEntryType": "Oracle", "Owner": "rNZ9m6AP9K7z3EVg6GhPMx36V4QmZKeWds", "OwnerNode": "0", "PreviousTxnID": "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9", "PreviousTxnLgrSeq": 3675418, "PriceDataSeries": [{"PriceData": {"AssetPrice": "2e4", "BaseAsset": "XRP", "QuoteAsset": "USD", "Scale": 3}}, {"PriceData": {"BaseAsset": "XRP", "QuoteAsset": "EUR"}}], "Provider": "70726F7669646572", "index": "CCA0C4F3B8C6A9F6B1B6D6E9C3A8F2B1E0D9C8B7A6F5E4D3C2B1A09F8E7D6C5B"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetOracle(req)
+			},
+			check: func(t *testing.T, entry any) {
+				oracle := entry.(*ledgerentry.Oracle)
+				require.Len(t, oracle.PriceDataSeries, 2)
+				require.Equal(t, uint64(0x2e4), oracle.PriceDataSeries[0].PriceData.AssetPrice)
+				require.Equal(t, uint8(3), oracle.PriceDataSeries[0].PriceData.Scale)
+				require.Zero(t, oracle.PriceDataSeries[1].PriceData.AssetPrice)
+			},
+		},
+		{
+			name: "pass - credential",
+			node: `{"CredentialType": "6D795F63726564656E7469616C", "Flags": 65536, "Issuer": "rL6bWkHSTrbMpBDBLiPbXVyb9KuAmsERiP", "IssuerNode": "0", "LedgerEntryType": "Credential", "PreviousTxnID": "7D1257779E2D298C07C7E0C73CD446534B143FBD1F13DB268A878E40FD153B9A", "PreviousTxnLgrSeq": 789, "Subject": "rGAJtNLCrJFzeSkd3XxqZn2RsyVo2FmDLu", "SubjectNode": "0", "URI": "6C6F63616C686F7374", "index": "DB2B8E9A7B7F2A3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetCredential(req)
+			},
+			check: func(t *testing.T, entry any) {
+				require.Equal(t, types.Address("rGAJtNLCrJFzeSkd3XxqZn2RsyVo2FmDLu"), entry.(*ledgerentry.Credential).Subject)
+			},
+		},
+		{
+			name: "pass - MPT issuance",
+			node: `{"AssetScale": 2, "Flags": 122, "Issuer": "rU6K7V3Po4snVhBBaU29sesqs2qTQJWDw1", "LedgerEntryType": "MPTokenIssuance", "MPTokenMetadata": "7B22746963686572223A22545354227D", "MaximumAmount": "9223372036854775807", "OutstandingAmount": "100", "OwnerNode": "0", "PreviousTxnID": "8089451B193AAD110ACED3D62BE79BB523658545E6EE8B7BB0BE573FED9BCBFB", "PreviousTxnLgrSeq": 234644, "Sequence": 1, "TransferFee": 314, "index": "A738A1E6E8505E1FC77BBB9FEF84FF9A9C609F2739E0F9573CDD6367100A0AA9"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetMPTIssuance(req)
+			},
+			check: func(t *testing.T, entry any) {
+				issuance := entry.(*ledgerentry.MPTokenIssuance)
+				require.Equal(t, uint64(9223372036854775807), issuance.MaximumAmount)
+				require.Equal(t, uint64(100), issuance.OutstandingAmount)
+				require.Equal(t, uint16(314), issuance.TransferFee)
+			},
+		},
+		{
+			name: "pass - MPToken",
+			node: `{"Account": "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD", "Flags": 0, "LedgerEntryType": "MPToken", "MPTAmount": "100", "MPTokenIssuanceID": "000004C463C52827307480341125DA0577DEFC38405B0E3E", "OwnerNode": "1", "PreviousTxnID": "8089451B193AAD110ACED3D62BE79BB523658545E6EE8B7BB0BE573FED9BCBFB", "PreviousTxnLgrSeq": 234644, "index": "A738A1E6E8505E1FC77BBB9FEF84FF9A9C609F2739E0F9573CDD6367100A0AA9"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetMPToken(req)
+			},
+			check: func(t *testing.T, entry any) {
+				mpToken := entry.(*ledgerentry.MPToken)
+				require.Equal(t, uint64(100), mpToken.MPTAmount)
+				require.Equal(t, uint64(1), mpToken.OwnerNode)
+			},
+		},
+		{
+			name: "pass - permissioned domain",
+			node: `{"AcceptedCredentials": [{"Credential": {"CredentialType": "4B5943", "Issuer": "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX"}}], "Flags": 0, "LedgerEntryType": "PermissionedDomain", "Owner": "rPDXxSZcuVL3ZWoyU82bcde3zwvmShkRyF", "OwnerNode": "0", "PreviousTxnID": "3E8964D5A86B3CD6B9ECB33310D4E073D64C865A5B866200AD2B7E29F8326702", "PreviousTxnLgrSeq": 8, "Sequence": 390, "index": "77D6234D074E505024D39C04C3F262997B773719AB29ACFA83119E4210328776"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetPermissionedDomain(req)
+			},
+			check: func(t *testing.T, entry any) {
+				domain := entry.(*ledgerentry.PermissionedDomain)
+				require.Equal(t, uint32(390), domain.Sequence)
+				require.Len(t, domain.AcceptedCredentials, 1)
+			},
+		},
+		{
+			name: "pass - bridge",
+			node: `{"Account": "r3nCVTbZGGYoWvZ58BcxDmiMUU7ChMa1eC", "Flags": 0, "LedgerEntryType": "Bridge", "MinAccountCreateAmount": "2000000000", "OwnerNode": "0", "PreviousTxnID": "67A8A1B36C1B97BE3AAB6B19CB3A3069034877DE917FD1A71919EAE7548E5636", "PreviousTxnLgrSeq": 102, "SignatureReward": "204", "XChainAccountClaimCount": "0", "XChainAccountCreateCount": "0", "XChainBridge": {"IssuingChainDoor": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "IssuingChainIssue": {"currency": "XRP"}, "LockingChainDoor": "r3nCVTbZGGYoWvZ58BcxDmiMUU7ChMa1eC", "LockingChainIssue": {"currency": "XRP"}}, "XChainClaimID": "1", "index": "9F2C9E23343852036AFD323025A8506018ABF9D4DBAA746D61BF1CFB5C297D10"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetBridge(req)
+			},
+			check: func(t *testing.T, entry any) {
+				bridge := entry.(*ledgerentry.Bridge)
+				require.Equal(t, types.XRPCurrencyAmount(204), bridge.SignatureReward)
+				require.Equal(t, types.XRPCurrencyAmount(2000000000), bridge.MinAccountCreateAmount)
+				require.Equal(t, ledgerentry.Asset{Currency: "XRP"}, bridge.XChainBridge.LockingChainIssue)
+				require.Equal(t, types.Address("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"), bridge.XChainBridge.IssuingChainDoor)
+			},
+		},
+		{
+			name: "pass - cross-chain claim ID",
+			node: `{"Account": "rBW1U7J9mEhEdk6dMHEFUjqQ7HW7WpaEMi", "Flags": 0, "LedgerEntryType": "XChainOwnedClaimID", "OtherChainSource": "r9oXrvBX5aDoyMGkoYvzazxDhYoWFUjz8p", "OwnerNode": "0", "PreviousTxnID": "1CFD80E9CF232B8EED62A52857DE97438D12230C06496932A81DEFA6E66070A6", "PreviousTxnLgrSeq": 58673, "SignatureReward": "100", "XChainBridge": {"IssuingChainDoor": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "IssuingChainIssue": {"currency": "XRP"}, "LockingChainDoor": "rMAXACCrp3Y8PpswXcg3bKggHX76V3F8M4", "LockingChainIssue": {"currency": "XRP"}}, "XChainClaimAttestations": [{"XChainClaimProofSig": {"Amount": "1000000", "AttestationRewardAccount": "rfgjrgEJGDxfUY2U8VEDs7BnB1jiH3ofu6", "AttestationSignerAccount": "rfsxNxZ6xB1nTPhTMwQajNnkCxWG8B714n", "Destination": "rBW1U7J9mEhEdk6dMHEFUjqQ7HW7WpaEMi", "PublicKey": "025CA526EF20567A50FEC504589F949E0E3401C13EF76DD5FD1CC2850FA485BD7B", "WasLockingChainSend": 1}}], "XChainClaimID": "b5", "index": "20B136D7BF6D2E3D610E28E3E6BE09F5C8F4F0241BBF6E2D072AE1BACB1388F5"}`,
+			get: func(c *Client, req *ledger.EntryRequest) (any, error) {
+				return c.GetXChainOwnedClaimID(req)
+			},
+			check: func(t *testing.T, entry any) {
+				claimID := entry.(*ledgerentry.XChainOwnedClaimID)
+				require.Equal(t, types.XRPCurrencyAmount(100), claimID.SignatureReward)
+				require.Equal(t, ledgerentry.Asset{Currency: "XRP"}, claimID.XChainBridge.IssuingChainIssue)
+				require.Len(t, claimID.XChainClaimAttestations, 1)
+				require.Equal(t, types.XRPCurrencyAmount(1000000), claimID.XChainClaimAttestations[0].XChainClaimProofSig.Amount)
+				require.Equal(t, "b5", claimID.XChainClaimID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := `{"result": {"index": "9CA88CDEDFF9252B3DE183CE35B038F57282BC9503CDFA1923EF9A95DF0D6F7B", "ledger_index": 3675418, "validated": true, "node": ` + tt.node + `}}`
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(response, 200, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			entry, err := tt.get(NewClient(cfg), &ledger.EntryRequest{Index: "9CA88CDEDFF9252B3DE183CE35B038F57282BC9503CDFA1923EF9A95DF0D6F7B"})
+			require.NoError(t, err)
+			tt.check(t, entry)
+		})
+	}
+}
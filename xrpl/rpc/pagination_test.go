@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_AccountObjectsAll(t *testing.T) {
+	pages := []string{
+		`{"result": {"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "ledger_index": 100, "validated": true, "account_objects": [{"index": "A"}, {"index": "B"}], "marker": "m1"}}`,
+		`{"result": {"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "ledger_index": 100, "validated": true, "account_objects": [{"index": "C"}]}}`,
+	}
+
+	var bodies []string
+	mc := testutil.JSONRPCMockClient{}
+	mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		bodies = append(bodies, string(body))
+		page := pages[0]
+		pages = pages[1:]
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(page)))}, nil
+	}
+
+	cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+	require.NoError(t, err)
+	client := NewClient(cfg)
+
+	var indexes []string
+	for object, err := range client.AccountObjectsAll(&account.ObjectsRequest{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", Limit: 2}) {
+		require.NoError(t, err)
+		indexes = append(indexes, object["index"].(string))
+	}
+
+	require.Equal(t, []string{"A", "B", "C"}, indexes)
+	require.Len(t, bodies, 2)
+	require.Contains(t, bodies[1], `"marker":"m1"`)
+	require.Contains(t, bodies[1], `"ledger_index":100`)
+}
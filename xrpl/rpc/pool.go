@@ -0,0 +1,386 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/engine"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+)
+
+// Pool is an HTTPClient that spreads requests over several XRPL servers.
+// It periodically checks the health of every endpoint with server_info and sends each request to the
+// healthiest one, ranked by validated ledger age and load factor. When an endpoint fails, answers with
+// a server error, or reports that it cannot serve the request (noNetwork, notSynced, noCurrent, tooBusy or
+// amendmentBlocked), the request is retried on the next endpoint and the endpoint is marked unhealthy until
+// its next successful health check. This also covers endpoints whose ledger went stale between health checks.
+//
+// Use NewPoolClient to get a Client exposing the usual query and submit methods on top of a Pool.
+type Pool struct {
+	endpoints  []*poolEndpoint
+	httpClient HTTPClient
+
+	healthCheckInterval time.Duration
+	maxLedgerAge        time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+
+	// mu guards answered and clioProbes.
+	mu sync.Mutex
+	// answered is the endpoint that answered the last request.
+	answered *poolEndpoint
+	// clioProbes are the Clio probes of the clients created with NewPoolClient, reset when
+	// another endpoint answers.
+	clioProbes []*engine.ClioProbe
+}
+
+// failoverErrors are the XRPL errors returned by servers that are unable to serve any request,
+// on which the Pool retries the request on another endpoint.
+var failoverErrors = []error{
+	xrpl.ErrNoNetwork,
+	xrpl.ErrNotSynced,
+	xrpl.ErrNoCurrent,
+	xrpl.ErrTooBusy,
+	xrpl.ErrAmendmentBlocked,
+}
+
+// EndpointStatus describes the last known state of a Pool endpoint.
+type EndpointStatus struct {
+	URL string
+	// Healthy is false if the last health check or request to the endpoint failed,
+	// or if its validated ledger is older than the configured max ledger age.
+	Healthy bool
+	// LedgerAge is the age in seconds of the last validated ledger of the endpoint.
+	LedgerAge uint
+	// LoadFactor is the load factor of the endpoint.
+	LoadFactor uint
+	// Err is the error of the last health check or request, if it failed.
+	Err error
+}
+
+type poolEndpoint struct {
+	url    *url.URL
+	client *Client
+
+	mu     sync.Mutex
+	status EndpointStatus
+}
+
+// PoolOpt represents a function that applies a configuration option to Pool.
+type PoolOpt func(p *Pool)
+
+// WithPoolHTTPClient returns a PoolOpt that sets the HTTPClient used to reach the endpoints.
+func WithPoolHTTPClient(cl HTTPClient) PoolOpt {
+	return func(p *Pool) {
+		p.httpClient = cl
+	}
+}
+
+// WithHealthCheckInterval returns a PoolOpt that sets the interval between endpoint health checks.
+// A zero interval disables background health checks.
+func WithHealthCheckInterval(interval time.Duration) PoolOpt {
+	return func(p *Pool) {
+		p.healthCheckInterval = interval
+	}
+}
+
+// WithMaxLedgerAge returns a PoolOpt that sets the maximum age of the last validated ledger of a healthy endpoint.
+func WithMaxLedgerAge(maxLedgerAge time.Duration) PoolOpt {
+	return func(p *Pool) {
+		p.maxLedgerAge = maxLedgerAge
+	}
+}
+
+// NewPool creates a Pool with the given endpoint URLs and applies any provided PoolOpt options.
+// Endpoints are preferred in the given order when they are equally healthy.
+// It starts checking the health of the endpoints in the background until Close is called.
+func NewPool(urls []string, opts ...PoolOpt) (*Pool, error) {
+	if len(urls) == 0 {
+		return nil, ErrNoPoolEndpoints
+	}
+
+	p := &Pool{
+		httpClient:          &http.Client{Timeout: common.DefaultTimeout},
+		healthCheckInterval: common.DefaultHealthCheckInterval,
+		maxLedgerAge:        common.DefaultMaxLedgerAge,
+		stop:                make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	for _, rawURL := range urls {
+		if len(rawURL) == 0 {
+			return nil, ErrEmptyURL
+		}
+		if !strings.HasSuffix(rawURL, "/") {
+			rawURL += "/"
+		}
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		// Health checks are repeated periodically, so they are not retried: a dead endpoint must not stall them.
+		cfg, err := NewClientConfig(rawURL, WithHTTPClient(p.httpClient), WithRetryPolicy(RetryPolicy{}))
+		if err != nil {
+			return nil, err
+		}
+		p.endpoints = append(p.endpoints, &poolEndpoint{
+			url:    u,
+			client: NewClient(cfg),
+			status: EndpointStatus{URL: rawURL, Healthy: true},
+		})
+	}
+
+	if p.healthCheckInterval > 0 {
+		go p.checkHealthPeriodically()
+	}
+
+	return p, nil
+}
+
+// NewPoolClient creates a Client that sends its requests through the pool.
+// The Clio detection of the client is repeated whenever a different endpoint answers, since the
+// endpoints of a pool may not all be Clio servers.
+func NewPoolClient(p *Pool, opts ...ConfigOpt) (*Client, error) {
+	cfg, err := NewClientConfig(p.endpoints[0].url.String(), append(opts, WithHTTPClient(p))...)
+	if err != nil {
+		return nil, err
+	}
+	c := NewClient(cfg)
+
+	p.mu.Lock()
+	p.clioProbes = append(p.clioProbes, &c.clio)
+	p.mu.Unlock()
+
+	return c, nil
+}
+
+// Close stops the background health checks.
+func (p *Pool) Close() {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+	})
+}
+
+// Endpoints returns the last known status of every endpoint, in the order they were given.
+func (p *Pool) Endpoints() []EndpointStatus {
+	statuses := make([]EndpointStatus, len(p.endpoints))
+	for i, ep := range p.endpoints {
+		statuses[i] = ep.getStatus()
+	}
+	return statuses
+}
+
+// CheckHealth queries server_info on every endpoint and updates their status.
+func (p *Pool) CheckHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, ep := range p.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.checkEndpoint(ctx, ep)
+		}()
+	}
+	wg.Wait()
+}
+
+// Do sends the request to the healthiest endpoint, failing over to the next ones on errors.
+// If every endpoint fails, it returns the last failed response, or the last error if no endpoint answered,
+// so that the Client reports it as it would for a single server.
+// It implements the HTTPClient interface.
+func (p *Pool) Do(req *http.Request) (*http.Response, error) {
+	var lastRes *http.Response
+	var lastErr error
+	for _, ep := range p.rankedEndpoints() {
+		r := req.Clone(req.Context())
+		r.URL = ep.url
+		r.Host = ep.url.Host
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+
+		res, err := p.httpClient.Do(r)
+		var xrplErr *xrpl.XRPLError
+		if err == nil {
+			xrplErr, err = checkFailoverError(res)
+		}
+		if err != nil {
+			if ctxErr := req.Context().Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			ep.markFailed(err)
+			lastRes, lastErr = nil, err
+			continue
+		}
+
+		// Server errors and servers unable to serve the request are worth retrying on another endpoint.
+		switch {
+		case res.StatusCode >= http.StatusInternalServerError:
+			ep.markFailed(&ClientError{ErrorString: res.Status})
+		case xrplErr != nil:
+			ep.markFailed(xrplErr)
+		default:
+			p.setAnswered(ep)
+			return res, nil
+		}
+		lastRes, lastErr = res, nil
+	}
+	if lastRes != nil {
+		return lastRes, nil
+	}
+	return nil, lastErr
+}
+
+// checkFailoverError reads the body of res and returns the XRPL error it holds if it is one of failoverErrors.
+// The body is replaced so that it can be read again by the caller.
+func checkFailoverError(res *http.Response) (*xrpl.XRPLError, error) {
+	b, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+
+	// Responses that are not a single JSON-RPC object, such as batches, are left to the caller.
+	var jr struct {
+		Result map[string]any `json:"result"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&jr); err != nil {
+		return nil, nil
+	}
+	if _, ok := jr.Result["error"].(string); !ok {
+		return nil, nil
+	}
+
+	xrplErr := newXRPLError(jr.Result)
+	for _, target := range failoverErrors {
+		if errors.Is(xrplErr, target) {
+			return xrplErr, nil
+		}
+	}
+	return nil, nil
+}
+
+// setAnswered records that ep answered a request, and resets the Clio probes of the pool clients if
+// the previous request was answered by another endpoint.
+func (p *Pool) setAnswered(ep *poolEndpoint) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.answered == ep {
+		return
+	}
+	if p.answered != nil {
+		for _, probe := range p.clioProbes {
+			probe.Reset()
+		}
+	}
+	p.answered = ep
+}
+
+func (p *Pool) checkHealthPeriodically() {
+	ticker := time.NewTicker(p.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), p.healthCheckInterval)
+		p.CheckHealth(ctx)
+		cancel()
+
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Pool) checkEndpoint(ctx context.Context, ep *poolEndpoint) {
+	res, err := ep.client.GetServerInfoContext(ctx, &server.InfoRequest{})
+	if err != nil {
+		ep.markFailed(err)
+		return
+	}
+
+	ledgerAge := res.Info.ValidatedLedger.Age
+	status := EndpointStatus{
+		URL:        ep.url.String(),
+		Healthy:    time.Duration(ledgerAge)*time.Second <= p.maxLedgerAge,
+		LedgerAge:  ledgerAge,
+		LoadFactor: res.Info.LoadFactor,
+	}
+	if !status.Healthy {
+		status.Err = ErrStaleLedger{Age: ledgerAge}
+	}
+
+	ep.mu.Lock()
+	ep.status = status
+	ep.mu.Unlock()
+}
+
+// rankedEndpoints returns the endpoints sorted from the healthiest to the least healthy:
+// healthy endpoints first, then by validated ledger age and load factor.
+func (p *Pool) rankedEndpoints() []*poolEndpoint {
+	type ranked struct {
+		ep     *poolEndpoint
+		status EndpointStatus
+	}
+	endpoints := make([]ranked, len(p.endpoints))
+	for i, ep := range p.endpoints {
+		endpoints[i] = ranked{ep: ep, status: ep.getStatus()}
+	}
+
+	slices.SortStableFunc(endpoints, func(a, b ranked) int {
+		switch {
+		case a.status.Healthy != b.status.Healthy:
+			if a.status.Healthy {
+				return -1
+			}
+			return 1
+		case a.status.LedgerAge != b.status.LedgerAge:
+			return int(a.status.LedgerAge) - int(b.status.LedgerAge)
+		default:
+			return int(a.status.LoadFactor) - int(b.status.LoadFactor)
+		}
+	})
+
+	sorted := make([]*poolEndpoint, len(endpoints))
+	for i, r := range endpoints {
+		sorted[i] = r.ep
+	}
+	return sorted
+}
+
+func (ep *poolEndpoint) getStatus() EndpointStatus {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	return ep.status
+}
+
+func (ep *poolEndpoint) markFailed(err error) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+
+	ep.status.Healthy = false
+	ep.status.Err = err
+}
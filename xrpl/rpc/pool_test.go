@@ -0,0 +1,354 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/stretchr/testify/require"
+)
+
+// newPoolTestServer starts a server answering server_info with the given validated ledger age and load factor,
+// and any other method with a result naming the server. It counts the non server_info requests received.
+func newPoolTestServer(t *testing.T, name string, ledgerAge, loadFactor uint, status int) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("error decoding request: %v", err)
+		}
+		if req.Method != "server_info" {
+			requests.Add(1)
+		}
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		result := map[string]any{"account": name}
+		if req.Method == "server_info" {
+			result = map[string]any{"info": map[string]any{
+				"load_factor":      loadFactor,
+				"validated_ledger": map[string]any{"age": ledgerAge},
+			}}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": result})
+	}))
+	t.Cleanup(s.Close)
+	return s, &requests
+}
+
+func TestNewPool(t *testing.T) {
+	_, err := NewPool(nil)
+	require.ErrorIs(t, err, ErrNoPoolEndpoints)
+
+	_, err = NewPool([]string{"http://localhost:5005", ""})
+	require.ErrorIs(t, err, ErrEmptyURL)
+}
+
+func TestPool_Do(t *testing.T) {
+	type testServer struct {
+		name       string
+		ledgerAge  uint
+		loadFactor uint
+		status     int
+	}
+
+	tests := []struct {
+		name             string
+		servers          []testServer
+		checkHealth      bool
+		expectedAccount  string
+		expectedRequests []int32
+		expectedHealthy  []bool
+	}{
+		{
+			name: "routes to the endpoint with the freshest validated ledger",
+			servers: []testServer{
+				{name: "a", ledgerAge: 5, loadFactor: 256, status: http.StatusOK},
+				{name: "b", ledgerAge: 1, loadFactor: 256, status: http.StatusOK},
+			},
+			checkHealth:      true,
+			expectedAccount:  "b",
+			expectedRequests: []int32{0, 1},
+			expectedHealthy:  []bool{true, true},
+		},
+		{
+			name: "routes to the least loaded endpoint when ledgers are equally fresh",
+			servers: []testServer{
+				{name: "a", ledgerAge: 1, loadFactor: 512, status: http.StatusOK},
+				{name: "b", ledgerAge: 1, loadFactor: 256, status: http.StatusOK},
+			},
+			checkHealth:      true,
+			expectedAccount:  "b",
+			expectedRequests: []int32{0, 1},
+			expectedHealthy:  []bool{true, true},
+		},
+		{
+			name: "avoids endpoints with a stale ledger",
+			servers: []testServer{
+				{name: "a", ledgerAge: 120, loadFactor: 256, status: http.StatusOK},
+				{name: "b", ledgerAge: 2, loadFactor: 1024, status: http.StatusOK},
+			},
+			checkHealth:      true,
+			expectedAccount:  "b",
+			expectedRequests: []int32{0, 1},
+			expectedHealthy:  []bool{false, true},
+		},
+		{
+			name: "fails over on server errors",
+			servers: []testServer{
+				{name: "a", status: http.StatusInternalServerError},
+				{name: "b", ledgerAge: 1, loadFactor: 256, status: http.StatusOK},
+			},
+			expectedAccount:  "b",
+			expectedRequests: []int32{1, 1},
+			expectedHealthy:  []bool{false, true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urls := make([]string, len(tt.servers))
+			counters := make([]*atomic.Int32, len(tt.servers))
+			for i, ts := range tt.servers {
+				s, requests := newPoolTestServer(t, ts.name, ts.ledgerAge, ts.loadFactor, ts.status)
+				urls[i] = s.URL
+				counters[i] = requests
+			}
+
+			pool, err := NewPool(urls, WithHealthCheckInterval(0))
+			require.NoError(t, err)
+			defer pool.Close()
+
+			if tt.checkHealth {
+				pool.CheckHealth(context.Background())
+			}
+
+			cl, err := NewPoolClient(pool)
+			require.NoError(t, err)
+
+			res, err := cl.Request(&account.ChannelsRequest{Account: "rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu"})
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedAccount, res.(*Response).Result["account"])
+
+			for i, counter := range counters {
+				require.Equal(t, tt.expectedRequests[i], counter.Load())
+			}
+			for i, status := range pool.Endpoints() {
+				require.Equal(t, tt.expectedHealthy[i], status.Healthy, status.URL)
+			}
+		})
+	}
+}
+
+func TestPool_DoAllEndpointsDown(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	s.Close()
+
+	pool, err := NewPool([]string{s.URL}, WithHealthCheckInterval(0))
+	require.NoError(t, err)
+	defer pool.Close()
+
+	cl, err := NewPoolClient(pool)
+	require.NoError(t, err)
+
+	_, err = cl.Request(&account.ChannelsRequest{Account: "rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu"})
+	require.Error(t, err)
+	require.False(t, pool.Endpoints()[0].Healthy)
+}
+
+func TestPool_DoFailsOverOnXRPLErrors(t *testing.T) {
+	tests := []struct {
+		name            string
+		token           string
+		expectedAccount string
+		expectedHealthy bool
+	}{
+		{
+			name:            "fails over when the endpoint is not synced",
+			token:           "notSynced",
+			expectedAccount: "b",
+			expectedHealthy: false,
+		},
+		{
+			name:            "fails over when the endpoint is too busy",
+			token:           "tooBusy",
+			expectedAccount: "b",
+			expectedHealthy: false,
+		},
+		{
+			name:            "fails over when the endpoint is amendment blocked",
+			token:           "amendmentBlocked",
+			expectedAccount: "b",
+			expectedHealthy: false,
+		},
+		{
+			name:            "does not fail over on request errors",
+			token:           "actNotFound",
+			expectedAccount: "",
+			expectedHealthy: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]any{"result": map[string]any{
+					"error":  tt.token,
+					"status": "error",
+				}})
+			}))
+			t.Cleanup(a.Close)
+			b, _ := newPoolTestServer(t, "b", 1, 256, http.StatusOK)
+
+			pool, err := NewPool([]string{a.URL, b.URL}, WithHealthCheckInterval(0))
+			require.NoError(t, err)
+			defer pool.Close()
+
+			cl, err := NewPoolClient(pool)
+			require.NoError(t, err)
+
+			res, err := cl.Request(&account.ChannelsRequest{Account: "rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu"})
+			if tt.expectedAccount == "" {
+				require.ErrorIs(t, err, &xrpl.XRPLError{Token: tt.token})
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedAccount, res.(*Response).Result["account"])
+			}
+			require.Equal(t, tt.expectedHealthy, pool.Endpoints()[0].Healthy)
+		})
+	}
+}
+
+func TestPool_DoLastEndpointFails(t *testing.T) {
+	tooBusy := func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": map[string]any{
+			"error":  "tooBusy",
+			"status": "error",
+		}})
+	}
+	serverError := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	tests := []struct {
+		name     string
+		handlers []http.HandlerFunc
+		// expectedToken is the XRPL error of the request, or empty for a server error.
+		expectedToken string
+	}{
+		{
+			name:          "single endpoint answering with a server error",
+			handlers:      []http.HandlerFunc{serverError},
+			expectedToken: "",
+		},
+		{
+			name:          "single endpoint too busy",
+			handlers:      []http.HandlerFunc{tooBusy},
+			expectedToken: "tooBusy",
+		},
+		{
+			name:          "last endpoint too busy after a server error",
+			handlers:      []http.HandlerFunc{serverError, tooBusy},
+			expectedToken: "tooBusy",
+		},
+		{
+			name:          "last endpoint answering with a server error after a too busy one",
+			handlers:      []http.HandlerFunc{tooBusy, serverError},
+			expectedToken: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urls := make([]string, len(tt.handlers))
+			for i, handler := range tt.handlers {
+				s := httptest.NewServer(handler)
+				t.Cleanup(s.Close)
+				urls[i] = s.URL
+			}
+
+			pool, err := NewPool(urls, WithHealthCheckInterval(0))
+			require.NoError(t, err)
+			defer pool.Close()
+
+			cl, err := NewPoolClient(pool)
+			require.NoError(t, err)
+
+			_, err = cl.Request(&account.ChannelsRequest{Account: "rLHmBn4fT92w4F6ViyYbjoizLTo83tHTHu"})
+			if tt.expectedToken == "" {
+				var clientErr *ClientError
+				require.ErrorAs(t, err, &clientErr)
+			} else {
+				require.ErrorIs(t, err, &xrpl.XRPLError{Token: tt.expectedToken})
+			}
+			for _, status := range pool.Endpoints() {
+				require.False(t, status.Healthy, status.URL)
+				require.Error(t, status.Err, status.URL)
+			}
+		})
+	}
+}
+
+func TestPool_ClioDetectionFollowsEndpoint(t *testing.T) {
+	var rippledDown atomic.Bool
+	serverInfo := func(info map[string]any) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]any{"result": map[string]any{"info": info}})
+		}
+	}
+	answerRippled := serverInfo(map[string]any{"build_version": "2.3.0"})
+	rippled := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rippledDown.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		answerRippled(w, r)
+	}))
+	t.Cleanup(rippled.Close)
+	clioServer := httptest.NewServer(serverInfo(map[string]any{"clio_version": "2.3.0"}))
+	t.Cleanup(clioServer.Close)
+
+	pool, err := NewPool([]string{rippled.URL, clioServer.URL}, WithHealthCheckInterval(0))
+	require.NoError(t, err)
+	defer pool.Close()
+
+	cl, err := NewPoolClient(pool, WithRetryPolicy(RetryPolicy{}))
+	require.NoError(t, err)
+
+	isClio, err := cl.IsClio()
+	require.NoError(t, err)
+	require.False(t, isClio)
+
+	// The next request fails over to the Clio server, which invalidates the detection.
+	rippledDown.Store(true)
+	_, err = cl.GetServerInfo(&server.InfoRequest{})
+	require.NoError(t, err)
+
+	isClio, err = cl.IsClio()
+	require.NoError(t, err)
+	require.True(t, isClio)
+}
+
+func TestPool_CheckHealthDoesNotRetry(t *testing.T) {
+	var requests atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(s.Close)
+
+	pool, err := NewPool([]string{s.URL}, WithHealthCheckInterval(0))
+	require.NoError(t, err)
+	defer pool.Close()
+
+	pool.CheckHealth(context.Background())
+	require.Equal(t, int32(1), requests.Load())
+	require.False(t, pool.Endpoints()[0].Healthy)
+}
@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"context"
+
 	"github.com/Peersyst/xrpl-go/xrpl/currency"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	channel "github.com/Peersyst/xrpl-go/xrpl/queries/channel"
@@ -10,6 +12,7 @@ import (
 	"github.com/Peersyst/xrpl-go/xrpl/queries/oracle"
 	path "github.com/Peersyst/xrpl-go/xrpl/queries/path"
 	server "github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -18,7 +21,12 @@ import (
 // It takes an AccountInfoRequest as input and returns an AccountInfoResponse,
 // along with the raw XRPL response and any error encountered.
 func (c *Client) GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountInfoContext(context.Background(), req)
+}
+
+// GetAccountInfoContext is like GetAccountInfo but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAccountInfoContext(ctx context.Context, req *account.InfoRequest) (*account.InfoResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +42,12 @@ func (c *Client) GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse
 // It takes an AccountChannelsRequest as input and returns an AccountChannelsResponse,
 // along with any error encountered.
 func (c *Client) GetAccountChannels(req *account.ChannelsRequest) (*account.ChannelsResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountChannelsContext(context.Background(), req)
+}
+
+// GetAccountChannelsContext is like GetAccountChannels but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAccountChannelsContext(ctx context.Context, req *account.ChannelsRequest) (*account.ChannelsResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +63,12 @@ func (c *Client) GetAccountChannels(req *account.ChannelsRequest) (*account.Chan
 // It takes an AccountObjectsRequest as input and returns an AccountObjectsResponse,
 // along with any error encountered.
 func (c *Client) GetAccountObjects(req *account.ObjectsRequest) (*account.ObjectsResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountObjectsContext(context.Background(), req)
+}
+
+// GetAccountObjectsContext is like GetAccountObjects but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAccountObjectsContext(ctx context.Context, req *account.ObjectsRequest) (*account.ObjectsResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +84,12 @@ func (c *Client) GetAccountObjects(req *account.ObjectsRequest) (*account.Object
 // It takes an AccountLinesRequest as input and returns an AccountLinesResponse,
 // along with any error encountered.
 func (c *Client) GetAccountLines(req *account.LinesRequest) (*account.LinesResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountLinesContext(context.Background(), req)
+}
+
+// GetAccountLinesContext is like GetAccountLines but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAccountLinesContext(ctx context.Context, req *account.LinesRequest) (*account.LinesResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +104,12 @@ func (c *Client) GetAccountLines(req *account.LinesRequest) (*account.LinesRespo
 // GetXrpBalance retrieves the XRP balance of a given account address.
 // It returns the balance as a string in XRP (not drops) and any error encountered.
 func (c *Client) GetXrpBalance(address types.Address) (string, error) {
-	res, err := c.GetAccountInfo(&account.InfoRequest{
+	return c.GetXrpBalanceContext(context.Background(), address)
+}
+
+// GetXrpBalanceContext is like GetXrpBalance but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetXrpBalanceContext(ctx context.Context, address types.Address) (string, error) {
+	res, err := c.GetAccountInfoContext(ctx, &account.InfoRequest{
 		Account: address,
 	})
 	if err != nil {
@@ -98,7 +126,12 @@ func (c *Client) GetXrpBalance(address types.Address) (string, error) {
 // It takes an AccountNFTsRequest as input and returns an AccountNFTsResponse,
 // along with any error encountered.
 func (c *Client) GetAccountNFTs(req *account.NFTsRequest) (*account.NFTsResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountNFTsContext(context.Background(), req)
+}
+
+// GetAccountNFTsContext is like GetAccountNFTs but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAccountNFTsContext(ctx context.Context, req *account.NFTsRequest) (*account.NFTsResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +147,12 @@ func (c *Client) GetAccountNFTs(req *account.NFTsRequest) (*account.NFTsResponse
 // It takes an AccountCurrenciesRequest as input and returns an AccountCurrenciesResponse,
 // along with any error encountered.
 func (c *Client) GetAccountCurrencies(req *account.CurrenciesRequest) (*account.CurrenciesResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountCurrenciesContext(context.Background(), req)
+}
+
+// GetAccountCurrenciesContext is like GetAccountCurrencies but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAccountCurrenciesContext(ctx context.Context, req *account.CurrenciesRequest) (*account.CurrenciesResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +169,12 @@ func (c *Client) GetAccountCurrencies(req *account.CurrenciesRequest) (*account.
 // It takes an AccountOffersRequest as input and returns an AccountOffersResponse,
 // along with any error encountered.
 func (c *Client) GetAccountOffers(req *account.OffersRequest) (*account.OffersResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountOffersContext(context.Background(), req)
+}
+
+// GetAccountOffersContext is like GetAccountOffers but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAccountOffersContext(ctx context.Context, req *account.OffersRequest) (*account.OffersResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +190,12 @@ func (c *Client) GetAccountOffers(req *account.OffersRequest) (*account.OffersRe
 // It takes an AccountTransactionsRequest as input and returns an AccountTransactionsResponse,
 // along with any error encountered.
 func (c *Client) GetAccountTransactions(req *account.TransactionsRequest) (*account.TransactionsResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountTransactionsContext(context.Background(), req)
+}
+
+// GetAccountTransactionsContext is like GetAccountTransactions but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAccountTransactionsContext(ctx context.Context, req *account.TransactionsRequest) (*account.TransactionsResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +211,12 @@ func (c *Client) GetAccountTransactions(req *account.TransactionsRequest) (*acco
 // It takes a GatewayBalancesRequest as input and returns a GatewayBalancesResponse,
 // along with any error encountered.
 func (c *Client) GetGatewayBalances(req *account.GatewayBalancesRequest) (*account.GatewayBalancesResponse, error) {
-	res, err := c.Request(req)
+	return c.GetGatewayBalancesContext(context.Background(), req)
+}
+
+// GetGatewayBalancesContext is like GetGatewayBalances but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetGatewayBalancesContext(ctx context.Context, req *account.GatewayBalancesRequest) (*account.GatewayBalancesResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -175,13 +228,40 @@ func (c *Client) GetGatewayBalances(req *account.GatewayBalancesRequest) (*accou
 	return &acr, nil
 }
 
+// GetNoRippleCheck compares the Default Ripple flag of an account and the No Ripple flag of its trust lines
+// with the recommended settings for its role.
+// It takes a NoRippleCheckRequest as input and returns a NoRippleCheckResponse,
+// along with any error encountered.
+func (c *Client) GetNoRippleCheck(req *account.NoRippleCheckRequest) (*account.NoRippleCheckResponse, error) {
+	return c.GetNoRippleCheckContext(context.Background(), req)
+}
+
+// GetNoRippleCheckContext is like GetNoRippleCheck but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetNoRippleCheckContext(ctx context.Context, req *account.NoRippleCheckRequest) (*account.NoRippleCheckResponse, error) {
+	res, err := c.RequestContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var acr account.NoRippleCheckResponse
+	err = res.GetResult(&acr)
+	if err != nil {
+		return nil, err
+	}
+	return &acr, nil
+}
+
 // Channel queries
 
 // GetChannelVerify verifies the signature of a payment channel claim.
 // It takes a ChannelVerifyRequest as input and returns a ChannelVerifyResponse,
 // along with any error encountered.
 func (c *Client) GetChannelVerify(req *channel.VerifyRequest) (*channel.VerifyResponse, error) {
-	res, err := c.Request(req)
+	return c.GetChannelVerifyContext(context.Background(), req)
+}
+
+// GetChannelVerifyContext is like GetChannelVerify but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetChannelVerifyContext(ctx context.Context, req *channel.VerifyRequest) (*channel.VerifyResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +278,12 @@ func (c *Client) GetChannelVerify(req *channel.VerifyRequest) (*channel.VerifyRe
 // GetLedgerIndex returns the index of the most recently validated ledger.
 // It returns the ledger index as a LedgerIndex type and any error encountered.
 func (c *Client) GetLedgerIndex() (common.LedgerIndex, error) {
-	res, err := c.Request(&ledger.Request{
+	return c.GetLedgerIndexContext(context.Background())
+}
+
+// GetLedgerIndexContext is like GetLedgerIndex but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetLedgerIndexContext(ctx context.Context) (common.LedgerIndex, error) {
+	res, err := c.RequestContext(ctx, &ledger.Request{
 		LedgerIndex: common.LedgerTitle("validated"),
 	})
 	if err != nil {
@@ -216,7 +301,12 @@ func (c *Client) GetLedgerIndex() (common.LedgerIndex, error) {
 // GetClosedLedger retrieves information about the last closed ledger.
 // It returns a ClosedResponse containing the ledger information and any error encountered.
 func (c *Client) GetClosedLedger() (*ledger.ClosedResponse, error) {
-	res, err := c.Request(&ledger.ClosedRequest{})
+	return c.GetClosedLedgerContext(context.Background())
+}
+
+// GetClosedLedgerContext is like GetClosedLedger but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetClosedLedgerContext(ctx context.Context) (*ledger.ClosedResponse, error) {
+	res, err := c.RequestContext(ctx, &ledger.ClosedRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -231,7 +321,12 @@ func (c *Client) GetClosedLedger() (*ledger.ClosedResponse, error) {
 // GetCurrentLedger retrieves information about the current working ledger.
 // It returns a CurrentResponse containing the ledger information and any error encountered.
 func (c *Client) GetCurrentLedger() (*ledger.CurrentResponse, error) {
-	res, err := c.Request(&ledger.CurrentRequest{})
+	return c.GetCurrentLedgerContext(context.Background())
+}
+
+// GetCurrentLedgerContext is like GetCurrentLedger but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetCurrentLedgerContext(ctx context.Context) (*ledger.CurrentResponse, error) {
+	res, err := c.RequestContext(ctx, &ledger.CurrentRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +342,12 @@ func (c *Client) GetCurrentLedger() (*ledger.CurrentResponse, error) {
 // It takes a DataRequest as input and returns a DataResponse containing the ledger data,
 // along with any error encountered.
 func (c *Client) GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, error) {
-	res, err := c.Request(req)
+	return c.GetLedgerDataContext(context.Background(), req)
+}
+
+// GetLedgerDataContext is like GetLedgerData but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetLedgerDataContext(ctx context.Context, req *ledger.DataRequest) (*ledger.DataResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -263,7 +363,12 @@ func (c *Client) GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, e
 // It takes a Request as input and returns a Response containing the ledger information,
 // along with any error encountered.
 func (c *Client) GetLedger(req *ledger.Request) (*ledger.Response, error) {
-	res, err := c.Request(req)
+	return c.GetLedgerContext(context.Background(), req)
+}
+
+// GetLedgerContext is like GetLedger but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetLedgerContext(ctx context.Context, req *ledger.Request) (*ledger.Response, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +384,12 @@ func (c *Client) GetLedger(req *ledger.Request) (*ledger.Response, error) {
 // It takes an EntryRequest as input and returns an EntryResponse containing the ledger entry,
 // along with any error encountered.
 func (c *Client) GetLedgerEntry(req *ledger.EntryRequest) (*ledger.EntryResponse, error) {
-	res, err := c.Request(req)
+	return c.GetLedgerEntryContext(context.Background(), req)
+}
+
+// GetLedgerEntryContext is like GetLedgerEntry but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetLedgerEntryContext(ctx context.Context, req *ledger.EntryRequest) (*ledger.EntryResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +407,12 @@ func (c *Client) GetLedgerEntry(req *ledger.EntryRequest) (*ledger.EntryResponse
 // It takes an NFTokenBuyOffersRequest as input and returns an NFTokenBuyOffersResponse,
 // along with any error encountered.
 func (c *Client) GetNFTBuyOffers(req *nft.NFTokenBuyOffersRequest) (*nft.NFTokenBuyOffersResponse, error) {
-	res, err := c.Request(req)
+	return c.GetNFTBuyOffersContext(context.Background(), req)
+}
+
+// GetNFTBuyOffersContext is like GetNFTBuyOffers but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetNFTBuyOffersContext(ctx context.Context, req *nft.NFTokenBuyOffersRequest) (*nft.NFTokenBuyOffersResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -313,7 +428,12 @@ func (c *Client) GetNFTBuyOffers(req *nft.NFTokenBuyOffersRequest) (*nft.NFToken
 // It takes an NFTokenSellOffersRequest as input and returns an NFTokenSellOffersResponse,
 // along with any error encountered.
 func (c *Client) GetNFTSellOffers(req *nft.NFTokenSellOffersRequest) (*nft.NFTokenSellOffersResponse, error) {
-	res, err := c.Request(req)
+	return c.GetNFTSellOffersContext(context.Background(), req)
+}
+
+// GetNFTSellOffersContext is like GetNFTSellOffers but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetNFTSellOffersContext(ctx context.Context, req *nft.NFTokenSellOffersRequest) (*nft.NFTokenSellOffersResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -327,11 +447,37 @@ func (c *Client) GetNFTSellOffers(req *nft.NFTokenSellOffersRequest) (*nft.NFTok
 
 // Path queries
 
+// GetAMMInfo retrieves the pool, LP tokens, trading fee, auction slot and votes of an AMM.
+// It takes an AMMInfoRequest as input and returns an AMMInfoResponse,
+// along with any error encountered.
+func (c *Client) GetAMMInfo(req *path.AMMInfoRequest) (*path.AMMInfoResponse, error) {
+	return c.GetAMMInfoContext(context.Background(), req)
+}
+
+// GetAMMInfoContext is like GetAMMInfo but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAMMInfoContext(ctx context.Context, req *path.AMMInfoRequest) (*path.AMMInfoResponse, error) {
+	res, err := c.RequestContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var lr path.AMMInfoResponse
+	err = res.GetResult(&lr)
+	if err != nil {
+		return nil, err
+	}
+	return &lr, nil
+}
+
 // GetBookOffers retrieves a list of offers between two currencies.
 // It takes a BookOffersRequest as input and returns a BookOffersResponse,
 // along with any error encountered.
 func (c *Client) GetBookOffers(req *path.BookOffersRequest) (*path.BookOffersResponse, error) {
-	res, err := c.Request(req)
+	return c.GetBookOffersContext(context.Background(), req)
+}
+
+// GetBookOffersContext is like GetBookOffers but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetBookOffersContext(ctx context.Context, req *path.BookOffersRequest) (*path.BookOffersResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -347,7 +493,12 @@ func (c *Client) GetBookOffers(req *path.BookOffersRequest) (*path.BookOffersRes
 // It takes a DepositAuthorizedRequest as input and returns a DepositAuthorizedResponse,
 // along with any error encountered.
 func (c *Client) GetDepositAuthorized(req *path.DepositAuthorizedRequest) (*path.DepositAuthorizedResponse, error) {
-	res, err := c.Request(req)
+	return c.GetDepositAuthorizedContext(context.Background(), req)
+}
+
+// GetDepositAuthorizedContext is like GetDepositAuthorized but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetDepositAuthorizedContext(ctx context.Context, req *path.DepositAuthorizedRequest) (*path.DepositAuthorizedResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +514,12 @@ func (c *Client) GetDepositAuthorized(req *path.DepositAuthorizedRequest) (*path
 // It takes a FindCreateRequest as input and returns a FindResponse,
 // along with any error encountered.
 func (c *Client) FindPathCreate(req *path.FindCreateRequest) (*path.FindResponse, error) {
-	res, err := c.Request(req)
+	return c.FindPathCreateContext(context.Background(), req)
+}
+
+// FindPathCreateContext is like FindPathCreate but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) FindPathCreateContext(ctx context.Context, req *path.FindCreateRequest) (*path.FindResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -379,7 +535,12 @@ func (c *Client) FindPathCreate(req *path.FindCreateRequest) (*path.FindResponse
 // It takes a FindCloseRequest as input and returns a FindResponse,
 // along with any error encountered.
 func (c *Client) FindPathClose(req *path.FindCloseRequest) (*path.FindResponse, error) {
-	res, err := c.Request(req)
+	return c.FindPathCloseContext(context.Background(), req)
+}
+
+// FindPathCloseContext is like FindPathClose but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) FindPathCloseContext(ctx context.Context, req *path.FindCloseRequest) (*path.FindResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -395,7 +556,12 @@ func (c *Client) FindPathClose(req *path.FindCloseRequest) (*path.FindResponse,
 // It takes a FindStatusRequest as input and returns a FindResponse,
 // along with any error encountered.
 func (c *Client) FindPathStatus(req *path.FindStatusRequest) (*path.FindResponse, error) {
-	res, err := c.Request(req)
+	return c.FindPathStatusContext(context.Background(), req)
+}
+
+// FindPathStatusContext is like FindPathStatus but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) FindPathStatusContext(ctx context.Context, req *path.FindStatusRequest) (*path.FindResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +577,12 @@ func (c *Client) FindPathStatus(req *path.FindStatusRequest) (*path.FindResponse
 // It takes a RipplePathFindRequest as input and returns a RipplePathFindResponse,
 // along with any error encountered.
 func (c *Client) GetRipplePathFind(req *path.RipplePathFindRequest) (*path.RipplePathFindResponse, error) {
-	res, err := c.Request(req)
+	return c.GetRipplePathFindContext(context.Background(), req)
+}
+
+// GetRipplePathFindContext is like GetRipplePathFind but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetRipplePathFindContext(ctx context.Context, req *path.RipplePathFindRequest) (*path.RipplePathFindResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -423,13 +594,62 @@ func (c *Client) GetRipplePathFind(req *path.RipplePathFindRequest) (*path.Rippl
 	return &lr, nil
 }
 
+// Transaction queries
+
+// GetTransaction retrieves a transaction by its hash or by its CTID, from any ledger the server has.
+// It takes a TxRequest as input and returns a TxResponse,
+// along with any error encountered.
+func (c *Client) GetTransaction(req *transactions.TxRequest) (*transactions.TxResponse, error) {
+	return c.GetTransactionContext(context.Background(), req)
+}
+
+// GetTransactionContext is like GetTransaction but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetTransactionContext(ctx context.Context, req *transactions.TxRequest) (*transactions.TxResponse, error) {
+	res, err := c.RequestContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var txr transactions.TxResponse
+	err = res.GetResult(&txr)
+	if err != nil {
+		return nil, err
+	}
+	return &txr, nil
+}
+
+// GetTransactionEntry retrieves a transaction from a specific ledger version.
+// It takes an EntryRequest as input and returns an EntryResponse,
+// along with any error encountered.
+func (c *Client) GetTransactionEntry(req *transactions.EntryRequest) (*transactions.EntryResponse, error) {
+	return c.GetTransactionEntryContext(context.Background(), req)
+}
+
+// GetTransactionEntryContext is like GetTransactionEntry but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetTransactionEntryContext(ctx context.Context, req *transactions.EntryRequest) (*transactions.EntryResponse, error) {
+	res, err := c.RequestContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var ter transactions.EntryResponse
+	err = res.GetResult(&ter)
+	if err != nil {
+		return nil, err
+	}
+	return &ter, nil
+}
+
 // Server queries
 
 // GetServerInfo retrieves information about the server.
 // It takes a ServerInfoRequest as input and returns a ServerInfoResponse,
 // along with any error encountered.
 func (c *Client) GetServerInfo(req *server.InfoRequest) (*server.InfoResponse, error) {
-	res, err := c.Request(req)
+	return c.GetServerInfoContext(context.Background(), req)
+}
+
+// GetServerInfoContext is like GetServerInfo but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetServerInfoContext(ctx context.Context, req *server.InfoRequest) (*server.InfoResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -445,7 +665,12 @@ func (c *Client) GetServerInfo(req *server.InfoRequest) (*server.InfoResponse, e
 // It takes a FeatureAllRequest as input and returns a FeatureAllResponse,
 // along with any error encountered.
 func (c *Client) GetAllFeatures(req *server.FeatureAllRequest) (*server.FeatureAllResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAllFeaturesContext(context.Background(), req)
+}
+
+// GetAllFeaturesContext is like GetAllFeatures but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAllFeaturesContext(ctx context.Context, req *server.FeatureAllRequest) (*server.FeatureAllResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -461,7 +686,12 @@ func (c *Client) GetAllFeatures(req *server.FeatureAllRequest) (*server.FeatureA
 // It takes a FeatureOneRequest as input and returns a FeatureResponse,
 // along with any error encountered.
 func (c *Client) GetFeature(req *server.FeatureOneRequest) (*server.FeatureResponse, error) {
-	res, err := c.Request(req)
+	return c.GetFeatureContext(context.Background(), req)
+}
+
+// GetFeatureContext is like GetFeature but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetFeatureContext(ctx context.Context, req *server.FeatureOneRequest) (*server.FeatureResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -477,7 +707,12 @@ func (c *Client) GetFeature(req *server.FeatureOneRequest) (*server.FeatureRespo
 // It takes a FeeRequest as input and returns a FeeResponse,
 // along with any error encountered.
 func (c *Client) GetFee(req *server.FeeRequest) (*server.FeeResponse, error) {
-	res, err := c.Request(req)
+	return c.GetFeeContext(context.Background(), req)
+}
+
+// GetFeeContext is like GetFee but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetFeeContext(ctx context.Context, req *server.FeeRequest) (*server.FeeResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -493,7 +728,12 @@ func (c *Client) GetFee(req *server.FeeRequest) (*server.FeeResponse, error) {
 // It takes a ManifestRequest as input and returns a ManifestResponse,
 // along with any error encountered.
 func (c *Client) GetManifest(req *server.ManifestRequest) (*server.ManifestResponse, error) {
-	res, err := c.Request(req)
+	return c.GetManifestContext(context.Background(), req)
+}
+
+// GetManifestContext is like GetManifest but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetManifestContext(ctx context.Context, req *server.ManifestRequest) (*server.ManifestResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -509,7 +749,12 @@ func (c *Client) GetManifest(req *server.ManifestRequest) (*server.ManifestRespo
 // It takes a StateRequest as input and returns a StateResponse,
 // along with any error encountered.
 func (c *Client) GetServerState(req *server.StateRequest) (*server.StateResponse, error) {
-	res, err := c.Request(req)
+	return c.GetServerStateContext(context.Background(), req)
+}
+
+// GetServerStateContext is like GetServerState but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetServerStateContext(ctx context.Context, req *server.StateRequest) (*server.StateResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -527,7 +772,12 @@ func (c *Client) GetServerState(req *server.StateRequest) (*server.StateResponse
 // It takes a GetAggregatePriceRequest as input and returns a GetAggregatePriceResponse,
 // along with any error encountered.
 func (c *Client) GetAggregatePrice(req *oracle.GetAggregatePriceRequest) (*oracle.GetAggregatePriceResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAggregatePriceContext(context.Background(), req)
+}
+
+// GetAggregatePriceContext is like GetAggregatePrice but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetAggregatePriceContext(ctx context.Context, req *oracle.GetAggregatePriceRequest) (*oracle.GetAggregatePriceResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -545,7 +795,12 @@ func (c *Client) GetAggregatePrice(req *oracle.GetAggregatePriceRequest) (*oracl
 // It takes a PingRequest as input and returns a PingResponse,
 // along with any error encountered.
 func (c *Client) Ping(req *utility.PingRequest) (*utility.PingResponse, error) {
-	res, err := c.Request(req)
+	return c.PingContext(context.Background(), req)
+}
+
+// PingContext is like Ping but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) PingContext(ctx context.Context, req *utility.PingRequest) (*utility.PingResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -561,7 +816,12 @@ func (c *Client) Ping(req *utility.PingRequest) (*utility.PingResponse, error) {
 // It takes a RandomRequest as input and returns a RandomResponse,
 // along with any error encountered.
 func (c *Client) GetRandom(req *utility.RandomRequest) (*utility.RandomResponse, error) {
-	res, err := c.Request(req)
+	return c.GetRandomContext(context.Background(), req)
+}
+
+// GetRandomContext is like GetRandom but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) GetRandomContext(ctx context.Context, req *utility.RandomRequest) (*utility.RandomResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,7 @@ import (
 	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
 	server "github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	servertypes "github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -993,6 +994,70 @@ func TestClient_GetGatewayBalances(t *testing.T) {
 	}
 }
 
+func TestClient_GetNoRippleCheck(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		mockStatus    int
+		request       *account.NoRippleCheckRequest
+		expected      *account.NoRippleCheckResponse
+		expectedError string
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"result": {
+					"ledger_current_index": 14342939,
+					"problems": ["You should immediately set your default ripple flag"],
+					"transactions": [
+						{"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "Fee": 10, "Sequence": 1406, "SetFlag": 8, "TransactionType": "AccountSet"}
+					],
+					"validated": false,
+					"status": "success"
+				}
+			}`,
+			mockStatus: 200,
+			request:    &account.NoRippleCheckRequest{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", Role: "gateway", Transactions: true},
+			expected: &account.NoRippleCheckResponse{
+				LedgerCurrentIndex: 14342939,
+				Problems:           []string{"You should immediately set your default ripple flag"},
+				Transactions: []transaction.FlatTransaction{
+					{"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "Fee": json.Number("10"), "Sequence": json.Number("1406"), "SetFlag": json.Number("8"), "TransactionType": "AccountSet"},
+				},
+			},
+		},
+		{
+			name:          "invalid request",
+			mockStatus:    200,
+			request:       &account.NoRippleCheckRequest{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"},
+			expectedError: account.ErrInvalidNoRippleCheckRole.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.mockResponse, tt.mockStatus, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			client := NewClient(cfg)
+
+			res, err := client.GetNoRippleCheck(tt.request)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
+
 func TestClient_GetChannelVerify(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1768,6 +1833,109 @@ func TestClient_GetDepositAuthorized(t *testing.T) {
 	}
 }
 
+func TestClient_GetAMMInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		mockStatus    int
+		request       *path.AMMInfoRequest
+		expected      *path.AMMInfoResponse
+		expectedError string
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"result": {
+					"amm": {
+						"account": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+						"amount": "296890496",
+						"amount2": {"currency": "TST", "issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", "value": "25.81656470648473"},
+						"asset2_frozen": false,
+						"auction_slot": {
+							"account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+							"auth_accounts": [{"account": "r9DKyNWtjXq1a1RFvtzDKvZKWaPn63rS23"}],
+							"discounted_fee": 60,
+							"expiration": "2023-Jan-26 00:28:40.000000000 UTC",
+							"price": {"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", "issuer": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM", "value": "0"},
+							"time_interval": 0
+						},
+						"lp_token": {"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", "issuer": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM", "value": "87533.41976112682"},
+						"trading_fee": 600,
+						"vote_slots": [{"account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm", "trading_fee": 600, "vote_weight": 9684}]
+					},
+					"ledger_current_index": 316745,
+					"validated": false,
+					"status": "success"
+				}
+			}`,
+			mockStatus: 200,
+			request: &path.AMMInfoRequest{
+				Asset:  &ledger.Asset{Currency: "XRP"},
+				Asset2: &ledger.Asset{Currency: "TST", Issuer: "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"},
+			},
+			expected: &path.AMMInfoResponse{
+				AMM: pathtypes.AMMPool{
+					Account: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+					Amount:  "296890496",
+					Amount2: map[string]any{"currency": "TST", "issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", "value": "25.81656470648473"},
+					AuctionSlot: &pathtypes.AMMAuctionSlot{
+						Account:       "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+						AuthAccounts:  []pathtypes.AMMAuthAccount{{Account: "r9DKyNWtjXq1a1RFvtzDKvZKWaPn63rS23"}},
+						DiscountedFee: 60,
+						Expiration:    "2023-Jan-26 00:28:40.000000000 UTC",
+						Price:         types.IssuedCurrencyAmount{Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", Issuer: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM", Value: "0"},
+					},
+					LPToken:    types.IssuedCurrencyAmount{Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", Issuer: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM", Value: "87533.41976112682"},
+					TradingFee: 600,
+					VoteSlots:  []pathtypes.AMMVoteSlot{{Account: "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm", TradingFee: 600, VoteWeight: 9684}},
+				},
+				LedgerCurrentIndex: 316745,
+			},
+		},
+		{
+			name: "error response",
+			mockResponse: `{
+				"result": {
+					"error": "actNotFound",
+					"status": "error"
+				}
+			}`,
+			mockStatus:    200,
+			request:       &path.AMMInfoRequest{AMMAccount: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM"},
+			expectedError: "actNotFound",
+		},
+		{
+			name:          "invalid request",
+			mockStatus:    200,
+			request:       &path.AMMInfoRequest{Asset: &ledger.Asset{Currency: "XRP"}},
+			expectedError: pathtypes.ErrIncompleteAMMAssetPair.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.mockResponse, tt.mockStatus, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			client := NewClient(cfg)
+
+			res, err := client.GetAMMInfo(tt.request)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
+
 func TestClient_FindPathCreate(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -2219,6 +2387,164 @@ func TestClient_GetRipplePathFind(t *testing.T) {
 	}
 }
 
+func TestClient_GetTransaction(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		mockStatus    int
+		request       *transactions.TxRequest
+		expected      *transactions.TxResponse
+		expectedError string
+	}{
+		{
+			name: "successful binary response",
+			mockResponse: `{
+				"result": {
+					"close_time_iso": "2025-04-03T16:28:41Z",
+					"ctid": "C005523E00000000",
+					"date": 797010521,
+					"hash": "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+					"ledger_hash": "793E56131D8D4ABFB27FA383BFC44F2978B046E023FF46C588D7E0C874C2472A",
+					"ledger_index": 348734,
+					"meta_blob": "201C00000000F8E5110061250005523E55",
+					"tx_blob": "1200032400000001201B0005524F",
+					"validated": true,
+					"status": "success"
+				}
+			}`,
+			mockStatus: 200,
+			request:    &transactions.TxRequest{CTID: "C005523E00000000", Binary: true},
+			expected: &transactions.TxResponse{
+				Date:         797010521,
+				CloseTimeISO: "2025-04-03T16:28:41Z",
+				CTID:         "C005523E00000000",
+				Hash:         "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+				LedgerHash:   "793E56131D8D4ABFB27FA383BFC44F2978B046E023FF46C588D7E0C874C2472A",
+				LedgerIndex:  348734,
+				MetaBlob:     "201C00000000F8E5110061250005523E55",
+				TxBlob:       "1200032400000001201B0005524F",
+				Validated:    true,
+			},
+		},
+		{
+			name: "error response",
+			mockResponse: `{
+				"result": {
+					"error": "txnNotFound",
+					"searched_all": true,
+					"status": "error"
+				}
+			}`,
+			mockStatus:    200,
+			request:       &transactions.TxRequest{Transaction: "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2", MinLedger: 348000, MaxLedger: 348800},
+			expectedError: "txnNotFound",
+		},
+		{
+			name:          "invalid request",
+			mockStatus:    200,
+			request:       &transactions.TxRequest{},
+			expectedError: transactions.ErrNoTxIdentifier.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.mockResponse, tt.mockStatus, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			client := NewClient(cfg)
+
+			res, err := client.GetTransaction(tt.request)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestClient_GetTransactionEntry(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		mockStatus    int
+		request       *transactions.EntryRequest
+		expected      *transactions.EntryResponse
+		expectedError string
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"result": {
+					"close_time_iso": "2020-07-03T17:12:10Z",
+					"hash": "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+					"ledger_hash": "793E56131D8D4ABFB27FA383BFC44F2978B046E023FF46C588D7E0C874C2472A",
+					"ledger_index": 56865245,
+					"metadata": {
+						"AffectedNodes": [],
+						"TransactionIndex": 4,
+						"TransactionResult": "tesSUCCESS"
+					},
+					"tx_json": {"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "TransactionType": "AccountSet"},
+					"validated": true,
+					"status": "success"
+				}
+			}`,
+			mockStatus: 200,
+			request:    &transactions.EntryRequest{LedgerIndex: common.LedgerIndex(56865245), TxHash: "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2"},
+			expected: &transactions.EntryResponse{
+				CloseTimeISO: "2020-07-03T17:12:10Z",
+				Hash:         "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+				LedgerHash:   "793E56131D8D4ABFB27FA383BFC44F2978B046E023FF46C588D7E0C874C2472A",
+				LedgerIndex:  56865245,
+				Metadata: transaction.TxObjMeta{
+					AffectedNodes:     []transaction.AffectedNode{},
+					TransactionIndex:  4,
+					TransactionResult: "tesSUCCESS",
+				},
+				Tx: transaction.FlatTransaction{"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "TransactionType": "AccountSet"},
+			},
+		},
+		{
+			name:          "invalid request",
+			mockStatus:    200,
+			request:       &transactions.EntryRequest{LedgerIndex: common.Validated},
+			expectedError: transactions.ErrNoTxHash.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.mockResponse, tt.mockStatus, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			client := NewClient(cfg)
+
+			res, err := client.GetTransactionEntry(tt.request)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
+
 func TestClient_GetServerInfo(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+)
+
+// RetryPolicy configures how the Client retries a request that failed with a retryable HTTP status
+// code or XRPL error. The delay before each retry doubles, starting at BaseDelay, and is capped at MaxDelay.
+// A Retry-After header sent by the server takes precedence over the computed delay.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay before a retry, including the one requested with Retry-After. Zero means no cap.
+	MaxDelay time.Duration
+	// Jitter is the fraction of the delay, between 0 and 1, that is randomized to spread the retries of
+	// concurrent clients.
+	Jitter float64
+	// RetryableStatusCodes are the HTTP status codes that are retried.
+	RetryableStatusCodes []int
+	// RetryableErrors are the XRPL errors that are retried. They are matched with errors.Is.
+	RetryableErrors []error
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when none is configured. It retries unavailable servers
+// up to 3 times, waiting 1, 2 and 4 seconds.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:           common.DefaultMaxRequestRetries,
+		BaseDelay:            common.DefaultRetryDelay,
+		MaxDelay:             common.DefaultMaxRetryDelay,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	}
+}
+
+// shouldRetry reports whether a request that got res and err on the given attempt, starting at 0, must be retried.
+func (p *RetryPolicy) shouldRetry(attempt int, res *http.Response, err error) bool {
+	if err == nil || attempt >= p.MaxRetries {
+		return false
+	}
+	if res != nil && slices.Contains(p.RetryableStatusCodes, res.StatusCode) {
+		return true
+	}
+	for _, target := range p.RetryableErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// delay returns how long to wait before retrying the given attempt, starting at 0.
+func (p *RetryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	if d, ok := retryAfter(res); ok {
+		return p.capDelay(d)
+	}
+
+	// Without a MaxDelay, stop doubling before the delay overflows.
+	limit := p.MaxDelay
+	if limit == 0 {
+		limit = math.MaxInt64 / 2
+	}
+	d := p.BaseDelay
+	for i := 0; i < attempt && d > 0 && d < limit; i++ {
+		d *= 2
+	}
+	d = p.capDelay(d)
+
+	if p.Jitter > 0 {
+		//nolint:gosec // jitter doesn't need a cryptographically secure source
+		d -= time.Duration(rand.Float64() * min(p.Jitter, 1) * float64(d))
+	}
+	return d
+}
+
+func (p *RetryPolicy) capDelay(d time.Duration) time.Duration {
+	if p.MaxDelay > 0 {
+		return min(d, p.MaxDelay)
+	}
+	return d
+}
+
+// retryAfter parses the Retry-After header of res, either in seconds or as an HTTP date.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
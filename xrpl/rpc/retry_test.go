@@ -0,0 +1,317 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries:           2,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+		RetryableErrors:      []error{xrpl.ErrSlowDown},
+	}
+
+	testCases := []struct {
+		name     string
+		attempt  int
+		res      *http.Response
+		err      error
+		expected bool
+	}{
+		{
+			name:     "pass - retryable status code",
+			res:      &http.Response{StatusCode: http.StatusServiceUnavailable},
+			err:      &ClientError{ErrorString: "Server is overloaded, rate limit exceeded"},
+			expected: true,
+		},
+		{
+			name:     "pass - retryable XRPL error",
+			res:      &http.Response{StatusCode: http.StatusOK},
+			err:      &xrpl.XRPLError{Token: "slowDown"},
+			expected: true,
+		},
+		{
+			name:     "fail - no error",
+			res:      &http.Response{StatusCode: http.StatusOK},
+			expected: false,
+		},
+		{
+			name:     "fail - non retryable XRPL error",
+			res:      &http.Response{StatusCode: http.StatusOK},
+			err:      &xrpl.XRPLError{Token: "actNotFound"},
+			expected: false,
+		},
+		{
+			name:     "fail - non retryable status code",
+			res:      &http.Response{StatusCode: http.StatusBadRequest},
+			err:      &ClientError{ErrorString: "Null Method"},
+			expected: false,
+		},
+		{
+			name:     "fail - transport error",
+			err:      errors.New("connection refused"),
+			expected: false,
+		},
+		{
+			name:     "fail - max retries reached",
+			attempt:  2,
+			res:      &http.Response{StatusCode: http.StatusServiceUnavailable},
+			err:      &ClientError{ErrorString: "Server is overloaded, rate limit exceeded"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, policy.shouldRetry(tc.attempt, tc.res, tc.err))
+		})
+	}
+}
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	unavailable := &ClientError{ErrorString: "Server is overloaded, rate limit exceeded"}
+
+	require.True(t, policy.shouldRetry(2, &http.Response{StatusCode: http.StatusServiceUnavailable}, unavailable))
+	require.False(t, policy.shouldRetry(3, &http.Response{StatusCode: http.StatusServiceUnavailable}, unavailable))
+	require.False(t, policy.shouldRetry(0, &http.Response{StatusCode: http.StatusTooManyRequests}, &ClientError{ErrorString: "Too Many Requests"}))
+	require.False(t, policy.shouldRetry(0, &http.Response{StatusCode: http.StatusOK}, xrpl.ErrTooBusy))
+	require.Equal(t, 4*time.Second, policy.delay(2, nil))
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  RetryPolicy
+		attempt int
+		res     *http.Response
+		min     time.Duration
+		max     time.Duration
+	}{
+		{
+			name:   "pass - first retry waits the base delay",
+			policy: RetryPolicy{BaseDelay: time.Second},
+			min:    time.Second,
+			max:    time.Second,
+		},
+		{
+			name:    "pass - delay doubles on every retry",
+			policy:  RetryPolicy{BaseDelay: time.Second},
+			attempt: 3,
+			min:     8 * time.Second,
+			max:     8 * time.Second,
+		},
+		{
+			name:    "pass - delay is capped",
+			policy:  RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second},
+			attempt: 10,
+			min:     5 * time.Second,
+			max:     5 * time.Second,
+		},
+		{
+			name:    "pass - uncapped delay doesn't overflow",
+			policy:  RetryPolicy{BaseDelay: time.Second},
+			attempt: 100,
+			min:     math.MaxInt64 / 2,
+			max:     math.MaxInt64,
+		},
+		{
+			name:    "pass - jitter shortens the delay",
+			policy:  RetryPolicy{BaseDelay: time.Second, Jitter: 0.5},
+			attempt: 1,
+			min:     time.Second,
+			max:     2 * time.Second,
+		},
+		{
+			name:   "pass - Retry-After in seconds",
+			policy: RetryPolicy{BaseDelay: time.Second, Jitter: 0.5},
+			res:    &http.Response{Header: http.Header{"Retry-After": {"3"}}},
+			min:    3 * time.Second,
+			max:    3 * time.Second,
+		},
+		{
+			name:   "pass - Retry-After is capped",
+			policy: RetryPolicy{BaseDelay: time.Second, MaxDelay: 2 * time.Second},
+			res:    &http.Response{Header: http.Header{"Retry-After": {"120"}}},
+			min:    2 * time.Second,
+			max:    2 * time.Second,
+		},
+		{
+			name:   "pass - Retry-After date in the past",
+			policy: RetryPolicy{BaseDelay: time.Second},
+			res:    &http.Response{Header: http.Header{"Retry-After": {"Wed, 21 Oct 2015 07:28:00 GMT"}}},
+			min:    0,
+			max:    0,
+		},
+		{
+			name:   "pass - invalid Retry-After is ignored",
+			policy: RetryPolicy{BaseDelay: time.Second},
+			res:    &http.Response{Header: http.Header{"Retry-After": {"soon"}}},
+			min:    time.Second,
+			max:    time.Second,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := tc.policy.delay(tc.attempt, tc.res)
+			require.GreaterOrEqual(t, d, tc.min)
+			require.LessOrEqual(t, d, tc.max)
+		})
+	}
+}
+
+func TestClient_RequestRetries(t *testing.T) {
+	successResponse := `{"result": {"account": "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD", "ledger_index": 71766343}}`
+	tooBusyResponse := `{"result": {"error": "tooBusy", "status": "error"}}`
+
+	testCases := []struct {
+		name          string
+		responses     []string
+		statusCodes   []int
+		policy        RetryPolicy
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "pass - retries XRPL errors until success",
+			responses:     []string{tooBusyResponse, tooBusyResponse, successResponse},
+			statusCodes:   []int{200, 200, 200},
+			policy:        RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, RetryableErrors: []error{xrpl.ErrTooBusy}},
+			expectedCalls: 3,
+		},
+		{
+			name:          "pass - retries status codes until success",
+			responses:     []string{"Bad Gateway", successResponse},
+			statusCodes:   []int{502, 200},
+			policy:        RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, RetryableStatusCodes: []int{502}},
+			expectedCalls: 2,
+		},
+		{
+			name:          "fail - max retries reached",
+			responses:     []string{tooBusyResponse, tooBusyResponse, tooBusyResponse},
+			statusCodes:   []int{200, 200, 200},
+			policy:        RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond, RetryableErrors: []error{xrpl.ErrTooBusy}},
+			expectedCalls: 3,
+			expectedErr:   xrpl.ErrTooBusy,
+		},
+		{
+			name:          "fail - retries disabled",
+			responses:     []string{tooBusyResponse},
+			statusCodes:   []int{200},
+			policy:        RetryPolicy{},
+			expectedCalls: 1,
+			expectedErr:   xrpl.ErrTooBusy,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var bodies []string
+
+			mc := &testutil.JSONRPCMockClient{}
+			mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				bodies = append(bodies, string(body))
+
+				i := mc.RequestCount
+				mc.RequestCount++
+				return testutil.MockResponse(tc.responses[i], tc.statusCodes[i], mc)(req)
+			}
+
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&logs, nil))
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithRetryPolicy(tc.policy), WithLogger(logger))
+			require.NoError(t, err)
+
+			_, err = NewClient(cfg).Request(&account.InfoRequest{Account: "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD"})
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expectedCalls, mc.RequestCount)
+			// Every retry is logged with its attempt number.
+			dec := json.NewDecoder(&logs)
+			for attempt := 1; attempt < tc.expectedCalls; attempt++ {
+				var record map[string]any
+				require.NoError(t, dec.Decode(&record))
+				require.Equal(t, "retrying request", record["msg"])
+				require.Equal(t, "WARN", record["level"])
+				require.Equal(t, "account_info", record["method"])
+				require.Equal(t, float64(attempt), record["attempt"])
+			}
+			require.False(t, dec.More())
+			// Every attempt must send the whole request body.
+			for _, body := range bodies {
+				require.Equal(t, bodies[0], body)
+				require.NotEmpty(t, body)
+			}
+		})
+	}
+}
+
+func TestClient_RequestRetriesTimeout(t *testing.T) {
+	successResponse := `{"result": {"account": "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD", "ledger_index": 71766343}}`
+	req := &account.InfoRequest{Account: "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD"}
+
+	t.Run("pass - the timeout applies to every attempt", func(t *testing.T) {
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+			mc.RequestCount++
+			if mc.RequestCount <= common.DefaultMaxRequestRetries {
+				return testutil.MockResponse(`Service Unavailable`, 503, mc)(req)
+			}
+			return testutil.MockResponse(successResponse, 200, mc)(req)
+		}
+
+		// The backoff of the default policy lasts longer than the default timeout.
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc), WithTimeout(common.DefaultTimeout))
+		require.NoError(t, err)
+
+		_, err = NewClient(cfg).Request(req)
+
+		require.NoError(t, err)
+		require.Equal(t, common.DefaultMaxRequestRetries+1, mc.RequestCount)
+	})
+
+	t.Run("fail - context done during the backoff", func(t *testing.T) {
+		mc := &testutil.JSONRPCMockClient{}
+		mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+			mc.RequestCount++
+			return testutil.MockResponse(`Service Unavailable`, 503, mc)(req)
+		}
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(mc))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err = NewClient(cfg).RequestContext(ctx, req)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		var clientErr *ClientError
+		require.ErrorAs(t, err, &clientErr)
+		require.Equal(t, "Server is overloaded, rate limit exceeded", clientErr.ErrorString)
+		require.Equal(t, 1, mc.RequestCount)
+	})
+}
@@ -4,12 +4,9 @@
 package types
 
 import (
-	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/Peersyst/xrpl-go/xrpl"
 )
 
 // SubmitOptions specifies options for submitting a single transaction via RPC.
-type SubmitOptions struct {
-	Autofill bool
-	Wallet   *wallet.Wallet
-	FailHard bool
-}
+// It's shared with the websocket client, see xrpl.SubmitOptions.
+type SubmitOptions = xrpl.SubmitOptions
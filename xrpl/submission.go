@@ -0,0 +1,51 @@
+package xrpl
+
+import "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+
+// Outcome is the final outcome of a transaction submitted reliably.
+type Outcome int
+
+const (
+	// OutcomeSuccess means the transaction was validated with the tesSUCCESS result.
+	OutcomeSuccess Outcome = iota + 1
+	// OutcomeTecFailed means the transaction was validated with a tec result: it destroyed the fee and
+	// consumed its sequence, but had no other effect.
+	OutcomeTecFailed
+	// OutcomeExpired means the last validated ledger passed the LastLedgerSequence of the transaction
+	// without including it. The transaction can never be validated.
+	OutcomeExpired
+	// OutcomeRejected means the server rejected the transaction when it was submitted, for example because
+	// it is malformed. The transaction can never be validated.
+	OutcomeRejected
+)
+
+// String returns the name of the outcome.
+func (o Outcome) String() string {
+	switch o {
+	case OutcomeSuccess:
+		return "success"
+	case OutcomeTecFailed:
+		return "tec-failed"
+	case OutcomeExpired:
+		return "expired"
+	case OutcomeRejected:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
+
+// SubmissionResult is the final result of a transaction submitted reliably.
+type SubmissionResult struct {
+	Outcome Outcome
+	// Hash is the hash of the signed transaction.
+	Hash string
+	// EngineResult is the TransactionResult of a validated transaction, or the result of its last
+	// submission otherwise.
+	EngineResult string
+	// Submissions is the number of times the transaction was submitted.
+	Submissions int
+	// Tx is the validated transaction, including its final metadata.
+	// It's only set for the OutcomeSuccess and OutcomeTecFailed outcomes.
+	Tx *transactions.TxResponse
+}
@@ -0,0 +1,26 @@
+package xrpl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutcome_String(t *testing.T) {
+	tests := []struct {
+		outcome  Outcome
+		expected string
+	}{
+		{outcome: OutcomeSuccess, expected: "success"},
+		{outcome: OutcomeTecFailed, expected: "tec-failed"},
+		{outcome: OutcomeExpired, expected: "expired"},
+		{outcome: OutcomeRejected, expected: "rejected"},
+		{outcome: Outcome(0), expected: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.outcome.String())
+		})
+	}
+}
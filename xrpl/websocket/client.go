@@ -2,27 +2,23 @@
 package websocket
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
-	"math"
-	"strconv"
-	"strings"
+	"log/slog"
 	"sync/atomic"
 	"time"
 
 	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
-	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/Peersyst/xrpl-go/xrpl/engine"
 	"github.com/Peersyst/xrpl-go/xrpl/hash"
-	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/Peersyst/xrpl-go/xrpl/ratelimit"
 	transaction "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/mitchellh/mapstructure"
 
-	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
-	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
-	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
-	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/Peersyst/xrpl-go/xrpl/wallet"
 	"github.com/Peersyst/xrpl-go/xrpl/websocket/interfaces"
 	wstypes "github.com/Peersyst/xrpl-go/xrpl/websocket/types"
@@ -38,13 +34,14 @@ const (
 	DefaultMaxFeeXRP float32 = 2
 
 	// RestrictedNetworks is the minimum network ID above which networks are considered restricted.
-	// Sidechains are expected to have network IDs above this.
 	// Networks with ID above this restricted number are expected to specify an accurate NetworkID field
 	// in every transaction to that chain to prevent replay attacks.
-	// Mainnet and testnet are exceptions. More context: https://github.com/XRPLF/rippled/pull/4370
-	RestrictedNetworks = 1024
+	RestrictedNetworks = engine.RestrictedNetworks
 	// RequiredNetworkIDVersion is the minimum XRPL server build version after which specifying NetworkID is required for restricted networks.
-	RequiredNetworkIDVersion = "1.11.0"
+	RequiredNetworkIDVersion = engine.RequiredNetworkIDVersion
+
+	// errBufferSize is the number of errors buffered for the OnError handler.
+	errBufferSize = 16
 )
 
 // Client is a WebSocket client for interacting with an XRPL server.
@@ -54,7 +51,6 @@ type Client struct {
 
 	// Channels
 	errChan          chan error
-	requestChan      chan *ClientResponse
 	ledgerClosedChan chan *streamtypes.LedgerStream
 	validationChan   chan *streamtypes.ValidationStream
 	transactionChan  chan *streamtypes.TransactionStream
@@ -62,20 +58,63 @@ type Client struct {
 	orderBookChan    chan *streamtypes.OrderBookStream
 	bookChangesChan  chan *streamtypes.BookChangesStream
 	consensusChan    chan *streamtypes.ConsensusStream
+	serverChan       chan *streamtypes.ServerStream
+	manifestChan     chan *streamtypes.ManifestStream
+	reconnectChan    chan *wstypes.ReconnectEvent
+
+	// Requests awaiting a response and the semaphore bounding how many can be in flight.
+	pending  pendingRequests
+	inFlight chan struct{}
+	limiter  *ratelimit.Limiter
+
+	// Subscriptions replayed after a reconnect, and whether the client was disconnected on purpose.
+	subscriptions activeSubscriptions
+	closed        atomic.Bool
+	keepAliveStop chan struct{}
+
+	streamMetrics streamMetrics
+
+	// clio caches whether the server is a Clio server, for the Clio-only methods.
+	clio engine.ClioProbe
+
+	// eng autofills, submits and waits for transactions.
+	eng *engine.Engine
+
+	// Methods provides the pagination, ledger entry and Clio methods of the client.
+	engine.Methods
+
+	// Transactions awaited through subscriptions by SubmitTxAndWait and SubmitTxBlobAndWait.
+	txWaiters txWaiters
 
 	idCounter atomic.Uint32
 	NetworkID uint32
 }
 
+// Client implements the transport-agnostic xrpl.Client interface.
+var _ xrpl.Client = (*Client)(nil)
+
 // NewClient creates a new WebSocket client using the provided ClientConfig.
 // This client will open and close a websocket connection for each request.
 func NewClient(cfg ClientConfig) *Client {
-	return &Client{
-		cfg:         cfg,
-		requestChan: make(chan *ClientResponse),
-		errChan:     make(chan error),
-		conn:        NewConnection(cfg.host),
+	c := &Client{
+		cfg:     cfg,
+		errChan: make(chan error, errBufferSize),
+		conn:    NewConnection(cfg.host),
+		limiter: ratelimit.New(cfg.rateLimit, cfg.rateLimitBurst),
 	}
+	if cfg.maxInFlightRequests > 0 {
+		c.inFlight = make(chan struct{}, cfg.maxInFlightRequests)
+	}
+	c.conn.dialer = cfg.newDialer()
+	c.conn.header = cfg.header
+	c.conn.readLimit = cfg.readLimit
+	c.conn.compression = cfg.compression
+	if cfg.pingInterval > 0 {
+		c.conn.readTimeout = cfg.pingInterval + cfg.pongTimeout
+	}
+	c.eng = newEngine(c)
+	c.Methods = engine.NewMethods(c.eng)
+	return c
 }
 
 // Connect opens a websocket connection to the server. It starts reading messages in a goroutine.
@@ -84,12 +123,22 @@ func (c *Client) Connect() error {
 	if err != nil {
 		return err
 	}
+	c.closed.Store(false)
 	go c.readMessages()
+	if c.cfg.pingInterval > 0 {
+		c.keepAliveStop = make(chan struct{})
+		go c.keepAlive(c.keepAliveStop)
+	}
 	return nil
 }
 
 // Disconnect closes the websocket connection.
 func (c *Client) Disconnect() error {
+	c.closed.Store(true)
+	if c.keepAliveStop != nil {
+		close(c.keepAliveStop)
+		c.keepAliveStop = nil
+	}
 	return c.conn.Disconnect()
 }
 
@@ -105,77 +154,24 @@ func (c *Client) FaucetProvider() commonconstants.FaucetProvider {
 
 // Autofill fills in the missing fields in a transaction.
 func (c *Client) Autofill(tx *transaction.FlatTransaction) error {
-	if err := c.setValidTransactionAddresses(tx); err != nil {
-		return err
-	}
-
-	err := c.setTransactionFlags(tx)
-	if err != nil {
-		return err
-	}
-
-	if _, ok := (*tx)["NetworkID"]; !ok {
-		if c.NetworkID != 0 {
-			(*tx)["NetworkID"] = c.NetworkID
-		}
-	}
-	if _, ok := (*tx)["Sequence"]; !ok {
-		err := c.setTransactionNextValidSequenceNumber(tx)
-		if err != nil {
-			return err
-		}
-	}
-	if _, ok := (*tx)["Fee"]; !ok {
-		err := c.calculateFeePerTransactionType(tx, 0)
-		if err != nil {
-			return err
-		}
-	}
-	if _, ok := (*tx)["LastLedgerSequence"]; !ok {
-		err := c.setLastLedgerSequence(tx)
-		if err != nil {
-			return err
-		}
-	}
+	return c.AutofillContext(context.Background(), tx)
+}
 
-	if txType, ok := (*tx)["TransactionType"].(string); ok {
-		if acc, ok := (*tx)["Account"].(types.Address); txType == transaction.AccountDeleteTx.String() && ok {
-			err := c.checkAccountDeleteBlockers(acc)
-			if err != nil {
-				return err
-			}
-		}
-		if txType == transaction.PaymentTx.String() {
-			err := c.checkPaymentAmounts(tx)
-			if err != nil {
-				return err
-			}
-		}
-		if txType == transaction.BatchTx.String() {
-			err := c.autofillRawTransactions(tx)
-			if err != nil {
-				return err
-			}
-		}
-	}
-	return nil
+// AutofillContext is like Autofill but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) AutofillContext(ctx context.Context, tx *transaction.FlatTransaction) error {
+	return c.eng.Autofill(ctx, tx)
 }
 
 // AutofillMultisigned fills in the missing fields in a multisigned transaction.
 // This function is used to fill in the missing fields in a multisigned transaction.
 // It fills in the missing fields in the transaction and calculates the fee per number of signers.
 func (c *Client) AutofillMultisigned(tx *transaction.FlatTransaction, nSigners uint64) error {
-	err := c.Autofill(tx)
-	if err != nil {
-		return err
-	}
-
-	err = c.calculateFeePerTransactionType(tx, nSigners)
-	if err != nil {
-		return err
-	}
+	return c.AutofillMultisignedContext(context.Background(), tx, nSigners)
+}
 
-	return nil
+// AutofillMultisignedContext is like AutofillMultisigned but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) AutofillMultisignedContext(ctx context.Context, tx *transaction.FlatTransaction, nSigners uint64) error {
+	return c.eng.AutofillMultisigned(ctx, tx, nSigners)
 }
 
 // FundWallet funds a wallet with XRP from the faucet.
@@ -196,12 +192,28 @@ func (c *Client) FundWallet(wallet *wallet.Wallet) error {
 // Request sends a request to the server and returns the response.
 // This function is used to send requests to the server.
 // It returns the response from the server.
+// It is safe to call Request from multiple goroutines: every response is matched
+// to its caller by request ID.
 func (c *Client) Request(req interfaces.Request) (*ClientResponse, error) {
+	return c.RequestContext(context.Background(), req)
+}
+
+// RequestContext is like Request but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) RequestContext(ctx context.Context, req interfaces.Request) (*ClientResponse, error) {
 	err := req.Validate()
 	if err != nil {
 		return nil, err
 	}
 
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := c.acquireRequestSlot(ctx); err != nil {
+		return nil, err
+	}
+	defer c.releaseRequestSlot()
+
 	id := c.idCounter.Add(1)
 
 	msg, err := c.formatRequest(req, int(id), nil)
@@ -209,28 +221,59 @@ func (c *Client) Request(req interfaces.Request) (*ClientResponse, error) {
 		return nil, err
 	}
 
+	event := &xrpl.RequestEvent{Method: req.Method(), ID: uint64(id), Payload: msg}
+	ctx = c.cfg.interceptors.BeforeRequest(ctx, event)
+	start := time.Now()
+
+	res, payload, err := c.send(ctx, int(id), msg)
+
+	c.cfg.interceptors.Complete(ctx, &xrpl.ResponseEvent{
+		Request: event,
+		Latency: time.Since(start),
+		Payload: payload,
+		Err:     err,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.cfg.loadFactorThrottling && req.Method() == "server_info" {
+		if loadFactor, ok := ratelimit.LoadFactorFromServerInfo(res.Result); ok {
+			c.limiter.SetLoadFactor(loadFactor)
+		}
+	}
+
+	return res, nil
+}
+
+// send writes the request message and waits for its response. It returns the response along with
+// the raw message it was read from.
+func (c *Client) send(ctx context.Context, id int, msg []byte) (*ClientResponse, []byte, error) {
 	if !c.IsConnected() {
-		return nil, ErrNotConnectedToServer
+		return nil, nil, ErrNotConnectedToServer
 	}
 
-	err = c.conn.WriteMessage(msg)
+	resChan := c.pending.add(id)
+	defer c.pending.remove(id)
+
+	err := c.conn.WriteMessage(msg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	res, err := c.awaitResponse(int(id))
+	pr, err := c.awaitResponse(ctx, resChan)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if res.ID != int(id) {
-		return nil, ErrIncorrectID
+	if pr.res.ID != id {
+		return nil, pr.raw, ErrIncorrectID
 	}
-	if err := res.CheckError(); err != nil {
-		return nil, err
+	if err := pr.res.CheckError(); err != nil {
+		return nil, pr.raw, err
 	}
 
-	return res, nil
+	return pr.res, pr.raw, nil
 }
 
 // SubmitTxBlob sends a pre-signed transaction blob to the server.
@@ -238,70 +281,73 @@ func (c *Client) Request(req interfaces.Request) (*ClientResponse, error) {
 // or a signing public key, and then submits it using a submission request.
 // The failHard flag determines how strictly errors are handled.
 func (c *Client) SubmitTxBlob(txBlob string, failHard bool) (*requests.SubmitResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
-	if err != nil {
-		return nil, err
-	}
-
-	_, okTxSig := tx["TxSignature"].(string)
-	_, okPubKey := tx["SigningPubKey"].(string)
-
-	if !okTxSig && !okPubKey {
-		return nil, ErrMissingTxSignatureOrSigningPubKey
-	}
+	return c.SubmitTxBlobContext(context.Background(), txBlob, failHard)
+}
 
-	return c.submitRequest(&requests.SubmitRequest{
-		TxBlob:   txBlob,
-		FailHard: failHard,
-	})
+// SubmitTxBlobContext is like SubmitTxBlob but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitTxBlobContext(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitResponse, error) {
+	return c.eng.SubmitTxBlob(ctx, txBlob, failHard)
 }
 
 // SubmitTx signs the transaction (if necessary) and submits it to the server
 // via a submission request. It applies the provided submit options to decide whether
 // to autofill missing fields and enforce failHard mode during submission.
 func (c *Client) SubmitTx(tx transaction.FlatTransaction, opts *wstypes.SubmitOptions) (*requests.SubmitResponse, error) {
-	txBlob, err := c.getSignedTx(tx, opts.Autofill, opts.Wallet)
+	return c.SubmitTxContext(context.Background(), tx, opts)
+}
+
+// SubmitTxContext is like SubmitTx but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitTxContext(ctx context.Context, tx transaction.FlatTransaction, opts *wstypes.SubmitOptions) (*requests.SubmitResponse, error) {
+	if opts == nil {
+		opts = &wstypes.SubmitOptions{}
+	}
+	e := c.eng
+	txBlob, err := e.SignTx(ctx, tx, opts.Autofill, opts.Wallet)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.submitRequest(&requests.SubmitRequest{
-		TxBlob:   txBlob,
-		FailHard: opts.FailHard,
-	})
+	return e.SubmitTxBlob(ctx, txBlob, opts.FailHard)
 }
 
 // SubmitMultisigned sends a multisigned transaction to the server and returns the response.
 // This function is used to send multisigned transactions to the server.
 // It returns the response from the server.
 func (c *Client) SubmitMultisigned(txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
-	if err != nil {
-		return nil, err
-	}
-	signers, okSigners := tx["Signers"].([]interface{})
-
-	if okSigners && len(signers) > 0 {
-		for _, sig := range signers {
-			signer := sig.(map[string]any)
-			signerData := signer["Signer"].(map[string]any)
-			if signerData["SigningPubKey"] == "" && signerData["TxnSignature"] == "" {
-				return nil, ErrSignerDataIsEmpty
-			}
-		}
-	}
+	return c.SubmitMultisignedContext(context.Background(), txBlob, failHard)
+}
 
-	return c.submitMultisignedRequest(&requests.SubmitMultisignedRequest{
-		Tx:       tx,
-		FailHard: failHard,
-	})
+// SubmitMultisignedContext is like SubmitMultisigned but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitMultisignedContext(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
+	return c.eng.SubmitMultisigned(ctx, txBlob, failHard)
+}
+
+// Simulate executes an unsigned transaction against the current open ledger without submitting it, and returns
+// its engine result and metadata. If autofill is true, the transaction is autofilled first, otherwise the server
+// fills its missing Fee, Sequence, SigningPubKey and NetworkID. Signed transactions are rejected with
+// transactions.ErrSimulateSignedTx. Use BalanceChanges on the response to preview the effect of the
+// transaction on balances.
+func (c *Client) Simulate(tx transaction.FlatTransaction, autofill bool) (*requests.SimulateResponse, error) {
+	return c.SimulateContext(context.Background(), tx, autofill)
+}
+
+// SimulateContext is like Simulate but uses ctx to cancel the requests and propagate deadlines.
+func (c *Client) SimulateContext(ctx context.Context, tx transaction.FlatTransaction, autofill bool) (*requests.SimulateResponse, error) {
+	return c.eng.Simulate(ctx, tx, autofill)
 }
 
 // SubmitTxBlobAndWait sends a pre-signed transaction blob to the server,
 // decodes it to retrieve the required LastLedgerSequence, submits the blob,
 // and then waits until the transaction is confirmed in a ledger. It returns
 // the transaction response if the submission is successful.
+// The client subscribes to the submitting account and the ledger stream while waiting, and only looks the
+// transaction up after a reconnect or once LastLedgerSequence is validated, unless WithTransactionPolling is set.
 func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.TxResponse, error) {
+	return c.SubmitTxBlobAndWaitContext(context.Background(), txBlob, failHard)
+}
+
+// SubmitTxBlobAndWaitContext is like SubmitTxBlobAndWait but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitTxBlobAndWaitContext(ctx context.Context, txBlob string, failHard bool) (*requests.TxResponse, error) {
 	tx, err := binarycodec.Decode(txBlob)
 	if err != nil {
 		return nil, err
@@ -309,25 +355,39 @@ func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.Tx
 
 	lastLedgerSequence, ok := tx["LastLedgerSequence"].(uint32)
 	if !ok {
-
 		return nil, ErrMissingLastLedgerSequenceInTransaction
-
 	}
-	txResponse, err := c.SubmitTxBlob(txBlob, failHard)
+
+	txHash, err := hash.SignTxBlob(txBlob)
 	if err != nil {
 		return nil, err
 	}
 
-	if txResponse.EngineResult != "tesSUCCESS" {
-		return nil, &ClientError{ErrorString: "transaction failed to submit with engine result: " + txResponse.EngineResult}
+	// Subscribe before submitting, so the validation of the transaction isn't missed.
+	var waiter *txWaiter
+	account, ok := tx["Account"].(string)
+	if ok && !c.cfg.pollTransactions {
+		waiter, err = c.watchTransaction(ctx, txHash, types.Address(account))
+		if err != nil {
+			return nil, err
+		}
+		defer c.unwatchTransaction(waiter)
 	}
 
-	txHash, err := hash.SignTxBlob(txBlob)
+	e := c.eng
+	txResponse, err := e.SubmitTxBlob(ctx, txBlob, failHard)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.waitForTransaction(txHash, lastLedgerSequence)
+	if txResponse.EngineResult != "tesSUCCESS" {
+		return nil, &ClientError{ErrorString: "transaction failed to submit with engine result: " + txResponse.EngineResult}
+	}
+
+	if waiter == nil {
+		return e.WaitForTransaction(ctx, txHash, lastLedgerSequence)
+	}
+	return c.waitForTransactionStream(ctx, waiter, lastLedgerSequence)
 }
 
 // SubmitTxAndWait prepares a transaction by ensuring it is fully signed,
@@ -335,89 +395,54 @@ func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.Tx
 // It validates that the transaction's EngineResult is successful before returning
 // the transaction response.
 func (c *Client) SubmitTxAndWait(tx transaction.FlatTransaction, opts *wstypes.SubmitOptions) (*requests.TxResponse, error) {
+	return c.SubmitTxAndWaitContext(context.Background(), tx, opts)
+}
+
+// SubmitTxAndWaitContext is like SubmitTxAndWait but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubmitTxAndWaitContext(ctx context.Context, tx transaction.FlatTransaction, opts *wstypes.SubmitOptions) (*requests.TxResponse, error) {
+	if opts == nil {
+		opts = &wstypes.SubmitOptions{}
+	}
 	// Get the signed transaction blob.
-	txBlob, err := c.getSignedTx(tx, opts.Autofill, opts.Wallet)
+	txBlob, err := c.eng.SignTx(ctx, tx, opts.Autofill, opts.Wallet)
 	if err != nil {
 		return nil, err
 	}
 
 	// Delegate to SubmitTxBlobAndWait to handle submission, engine result check,
 	// ledger sequence validation, and waiting for confirmation.
-	return c.SubmitTxBlobAndWait(txBlob, opts.FailHard)
+	return c.SubmitTxBlobAndWaitContext(ctx, txBlob, opts.FailHard)
 }
 
-func (c *Client) waitForTransaction(txHash string, lastLedgerSequence uint32) (*requests.TxResponse, error) {
-	var txResponse *requests.TxResponse
-	i := 0
-
-	for i < c.cfg.maxRetries {
-		// Get the current ledger index
-		currentLedger, err := c.GetLedgerIndex()
-		if err != nil {
-			return nil, err
-		}
-
-		// Check if the transaction has been included in the current ledger
-		if currentLedger.Int() >= int(lastLedgerSequence) {
-			break
-		}
-
-		// Request the transaction from the server
-		res, err := c.Request(&requests.TxRequest{
-			Transaction: txHash,
-		})
-		if err != nil && !strings.Contains(err.Error(), txnNotFound) {
-			return nil, err
-		}
-
-		if res != nil {
-			err = res.GetResult(&txResponse)
-			if err != nil {
-				return nil, err
-			}
-
-			// Check if the transaction has been included in the current ledger
-			if txResponse.LedgerIndex.Int() >= int(lastLedgerSequence) {
-				break
-			}
-		}
-
-		// Wait for the retry delay before retrying
-		time.Sleep(c.cfg.retryDelay)
-		i++
-	}
-
-	if txResponse == nil {
-		return nil, ErrTransactionNotFound
-	}
+// SubmitTxBlobReliable submits a pre-signed transaction blob, resubmitting it while its preliminary
+// result is retriable, and waits until its outcome is final: validated with tesSUCCESS or a tec result,
+// expired once the last validated ledger passes its LastLedgerSequence, or rejected by the server.
+// Unlike SubmitTxBlobAndWait, it isn't bounded by the configured max retries.
+func (c *Client) SubmitTxBlobReliable(txBlob string, failHard bool) (*xrpl.SubmissionResult, error) {
+	return c.SubmitTxBlobReliableContext(context.Background(), txBlob, failHard)
+}
 
-	return txResponse, nil
+// SubmitTxBlobReliableContext is like SubmitTxBlobReliable but uses ctx to cancel the requests and propagate deadlines.
+func (c *Client) SubmitTxBlobReliableContext(ctx context.Context, txBlob string, failHard bool) (*xrpl.SubmissionResult, error) {
+	return c.eng.SubmitTxBlobReliable(ctx, txBlob, failHard)
 }
 
-func (c *Client) submitMultisignedRequest(req *requests.SubmitMultisignedRequest) (*requests.SubmitMultisignedResponse, error) {
-	res, err := c.Request(req)
-	if err != nil {
-		return nil, err
-	}
-	var subRes requests.SubmitMultisignedResponse
-	err = res.GetResult(&subRes)
-	if err != nil {
-		return nil, err
-	}
-	return &subRes, nil
+// SubmitTxReliable signs the transaction (if necessary) and submits it like SubmitTxBlobReliable,
+// returning its final outcome.
+func (c *Client) SubmitTxReliable(tx transaction.FlatTransaction, opts *wstypes.SubmitOptions) (*xrpl.SubmissionResult, error) {
+	return c.SubmitTxReliableContext(context.Background(), tx, opts)
 }
 
-func (c *Client) submitRequest(req *requests.SubmitRequest) (*requests.SubmitResponse, error) {
-	res, err := c.Request(req)
-	if err != nil {
-		return nil, err
+// SubmitTxReliableContext is like SubmitTxReliable but uses ctx to cancel the requests and propagate deadlines.
+func (c *Client) SubmitTxReliableContext(ctx context.Context, tx transaction.FlatTransaction, opts *wstypes.SubmitOptions) (*xrpl.SubmissionResult, error) {
+	if opts == nil {
+		opts = &wstypes.SubmitOptions{}
 	}
-	var subRes requests.SubmitResponse
-	err = res.GetResult(&subRes)
+	txBlob, err := c.eng.SignTx(ctx, tx, opts.Autofill, opts.Wallet)
 	if err != nil {
 		return nil, err
 	}
-	return &subRes, nil
+	return c.SubmitTxBlobReliableContext(ctx, txBlob, opts.FailHard)
 }
 
 func (c *Client) formatRequest(req interfaces.Request, id int, marker any) ([]byte, error) {
@@ -437,272 +462,42 @@ func (c *Client) formatRequest(req interfaces.Request, id int, marker any) ([]by
 	return json.Marshal(m)
 }
 
-// TODO: Implement this when IsValidXAddress is implemented
-func (c *Client) getClassicAccountAndTag(address string) (string, uint32) {
-	return address, 0
-}
-
-func (c *Client) convertTransactionAddressToClassicAddress(tx *transaction.FlatTransaction, fieldName string) {
-	if address, ok := (*tx)[fieldName].(string); ok {
-		classicAddress, _ := c.getClassicAccountAndTag(address)
-		(*tx)[fieldName] = classicAddress
-	}
-}
-
-func (c *Client) validateTransactionAddress(tx *transaction.FlatTransaction, addressField, tagField string) error {
-	classicAddress, tag := c.getClassicAccountAndTag((*tx)[addressField].(string))
-	(*tx)[addressField] = classicAddress
-
-	if tag != uint32(0) {
-		if txTag, ok := (*tx)[tagField].(uint32); ok && txTag != tag {
-			return fmt.Errorf("the %s, if present, must be equal to the tag of the %s", addressField, tagField)
-		}
-		(*tx)[tagField] = tag
-	}
-
-	return nil
-}
-
-// Sets valid addresses for the transaction.
-func (c *Client) setValidTransactionAddresses(tx *transaction.FlatTransaction) error {
-	// Validate if "Account" address is an xAddress
-	if err := c.validateTransactionAddress(tx, "Account", "SourceTag"); err != nil {
-		return err
-	}
-
-	if _, ok := (*tx)["Destination"]; ok {
-		if err := c.validateTransactionAddress(tx, "Destination", "DestinationTag"); err != nil {
-			return err
-		}
-	}
-
-	// DepositPreuaht
-	c.convertTransactionAddressToClassicAddress(tx, "Authorize")
-	c.convertTransactionAddressToClassicAddress(tx, "Unauthorize")
-	// EscrowCancel, EscrowFinish
-	c.convertTransactionAddressToClassicAddress(tx, "Owner")
-	// SetRegularKey
-	c.convertTransactionAddressToClassicAddress(tx, "RegularKey")
-
-	return nil
-}
-
-// Sets the next valid sequence number for a given transaction.
-func (c *Client) setTransactionNextValidSequenceNumber(tx *transaction.FlatTransaction) error {
-	if _, ok := (*tx)["Account"].(string); !ok {
-		return ErrMissingAccountInTransaction
-	}
-	res, err := c.GetAccountInfo(&account.InfoRequest{
-		Account:     types.Address((*tx)["Account"].(string)),
-		LedgerIndex: common.LedgerTitle("current"),
-	})
-
-	if err != nil {
-		return err
-	}
-
-	(*tx)["Sequence"] = uint32(res.AccountData.Sequence)
-	return nil
-}
-
-// Calculates the current transaction fee for the ledger.
-// Note: This is a public API that can be called directly.
-func (c *Client) getFeeXrp(cushion float32) (string, error) {
-	res, err := c.GetServerInfo(&server.InfoRequest{})
-	if err != nil {
-		return "", err
-	}
-
-	if res.Info.ValidatedLedger.BaseFeeXRP == 0 {
-		return "", ErrCouldNotGetBaseFeeXrp
-	}
-
-	loadFactor := res.Info.LoadFactor
-	if res.Info.LoadFactor == 0 {
-		loadFactor = 1
-	}
-
-	fee := res.Info.ValidatedLedger.BaseFeeXRP * float32(loadFactor) * cushion
-
-	if fee > c.cfg.maxFeeXRP {
-		fee = c.cfg.maxFeeXRP
-	}
-
-	// Round fee to NUM_DECIMAL_PLACES
-	roundedFee := float32(math.Round(float64(fee)*math.Pow10(int(currency.MaxFractionLength)))) / float32(math.Pow10(int(currency.MaxFractionLength)))
-
-	// Convert the rounded fee back to a string with NUM_DECIMAL_PLACES
-	return fmt.Sprintf("%.*f", currency.MaxFractionLength, roundedFee), nil
-}
-
-// Calculates the fee per transaction type.
-//
-// Enhanced implementation that replicates calculateFeePerTransactionType logic,
-// including special cases for EscrowFinish, AccountDelete, AMMCreate, Batch, and multi-signing.
-func (c *Client) calculateFeePerTransactionType(tx *transaction.FlatTransaction, nSigners uint64) error {
-	// Get base network fee
-	netFeeXRP, err := c.getFeeXrp(c.cfg.feeCushion)
-	if err != nil {
-		return err
-	}
-
-	netFeeDrops, err := currency.XrpToDrops(netFeeXRP)
-	if err != nil {
-		return err
-	}
-
-	// Convert to uint64 for calculations
-	baseFeeUint, err := strconv.ParseUint(netFeeDrops, 10, 64)
-	if err != nil {
-		return err
-	}
-
-	baseFee := baseFeeUint
-
-	// Get transaction type
-	transactionType := ""
-	if txType, ok := (*tx)["TransactionType"]; ok {
-		if str, ok := txType.(string); ok {
-			transactionType = str
-		}
-	}
-
-	// Check if this is a special transaction cost type
-	isSpecialTxCost := transactionType == "AccountDelete" || transactionType == "AMMCreate"
-
-	switch transactionType {
-	case "EscrowFinish":
-		if fulfillment, ok := (*tx)["Fulfillment"]; ok && fulfillment != nil {
-			if fulfillmentStr, ok := fulfillment.(string); ok && fulfillmentStr != "" {
-				fulfillmentBytesSize := (len(fulfillmentStr) + 1) / 2 // Math.ceil(length / 2)
-				if fulfillmentBytesSize < 0 {
-					return ErrInvalidFulfillmentLength
-				}
-				// BaseFee × (33 + ceil(Fulfillment size in bytes / 16))
-				chunks := (uint64(fulfillmentBytesSize) + 15) / 16 // ceil division
-				baseFee = baseFeeUint * (33 + chunks)
-			}
-		}
-	case "AccountDelete", "AMMCreate":
-		reserveFee, err := c.fetchOwnerReserveFee()
-		if err != nil {
-			return err
-		}
-		baseFee = reserveFee
-	case "Batch":
-		rawTxFees, err := c.calculateBatchFees(tx)
-		if err != nil {
-			return err
-		}
-		baseFee = baseFeeUint*2 + rawTxFees
-	case "LoanSet":
-		// For LoanSet, account for counterparty signers
-		counterPartySignersCount, err := c.fetchCounterPartySignersCount(*tx)
-		if err != nil {
-			return err
-		}
-		baseFee = baseFeeUint + (baseFeeUint * counterPartySignersCount)
-	}
-
-	// Multi-signed Transaction: BaseFee × (1 + Number of Signatures Provided)
-	if nSigners > 0 {
-		signersFee := baseFeeUint * nSigners
-		baseFee += signersFee
-	}
-
-	// Apply max fee limit (but not for special transaction cost types)
-	var totalFee uint64
-	if isSpecialTxCost {
-		totalFee = baseFee
-	} else {
-		maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", c.cfg.maxFeeXRP))
-		if err != nil {
-			return err
-		}
-		maxFeeUint, err := strconv.ParseUint(maxFeeDrops, 10, 64)
-		if err != nil {
-			return err
-		}
-		if baseFee < maxFeeUint {
-			totalFee = baseFee
-		} else {
-			totalFee = maxFeeUint
-		}
-	}
-
-	(*tx)["Fee"] = strconv.FormatUint(totalFee, 10)
-	return nil
-}
-
-// Sets the latest validated ledger sequence for the transaction.
-// Modifies the `LastLedgerSequence` field in the tx.
-func (c *Client) setLastLedgerSequence(tx *transaction.FlatTransaction) error {
-	index, err := c.GetLedgerIndex()
-	if err != nil {
-		return err
-	}
-
-	(*tx)["LastLedgerSequence"] = index.Uint32() + commonconstants.LedgerOffset
-	return err
-}
-
-// Checks for any blockers that prevent the deletion of an account.
-// Returns nil if there are no blockers, otherwise returns an error.
-func (c *Client) checkAccountDeleteBlockers(address types.Address) error {
-	accObjects, err := c.GetAccountObjects(&account.ObjectsRequest{
-		Account:              address,
-		LedgerIndex:          common.LedgerTitle("validated"),
-		DeletionBlockersOnly: true,
-	})
-	if err != nil {
-		return err
+// acquireRequestSlot blocks until the number of in-flight requests is below the configured cap.
+// It returns immediately when no cap is configured.
+func (c *Client) acquireRequestSlot(ctx context.Context) error {
+	if c.inFlight == nil {
+		return nil
 	}
-
-	if len(accObjects.AccountObjects) > 0 {
-		return ErrAccountCannotBeDeleted
+	select {
+	case c.inFlight <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-	return nil
 }
 
-func (c *Client) checkPaymentAmounts(tx *transaction.FlatTransaction) error {
-	if _, ok := (*tx)["DeliverMax"]; ok {
-		if _, ok := (*tx)["Amount"]; !ok {
-			(*tx)["Amount"] = (*tx)["DeliverMax"]
-		} else if (*tx)["Amount"] != (*tx)["DeliverMax"] {
-			return ErrAmountAndDeliverMaxMustBeIdentical
-		}
+// releaseRequestSlot frees a slot taken by acquireRequestSlot.
+func (c *Client) releaseRequestSlot() {
+	if c.inFlight == nil {
+		return
 	}
-	return nil
+	<-c.inFlight
 }
 
-// Sets a transaction's flags to its numeric representation.
-// TODO: Add flag support for AMMDeposit, AMMWithdraw,
-// NFTTOkenCreateOffer, NFTokenMint, OfferCreate, XChainModifyBridge (not supported).
-func (c *Client) setTransactionFlags(tx *transaction.FlatTransaction) error {
-	flags, ok := (*tx)["Flags"].(uint32)
-	if !ok && flags > 0 {
-		(*tx)["Flags"] = int(0)
-		return nil
-	}
-
-	_, ok = (*tx)["TransactionType"].(string)
-	if !ok {
-		return ErrTransactionTypeMissing
-	}
+func (c *Client) awaitResponse(ctx context.Context, resChan <-chan *pendingResponse) (*pendingResponse, error) {
+	timer := time.NewTimer(c.cfg.timeout)
+	defer timer.Stop()
 
-	return nil
-}
-
-func (c *Client) awaitResponse(id int) (*ClientResponse, error) {
-	for {
-		select {
-		case res := <-c.requestChan:
-			if res.ID == id {
-				return res, nil
-			}
-		case <-time.After(c.cfg.timeout):
-			return nil, ErrRequestTimedOut
+	select {
+	case res, ok := <-resChan:
+		if !ok {
+			return nil, ErrConnectionLost
 		}
+		return res, nil
+	case <-timer.C:
+		return nil, ErrRequestTimedOut
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 }
 
@@ -716,18 +511,27 @@ func (c *Client) handleMessage(message []byte) {
 	}
 }
 
+// handleRequest delivers a response to the request waiting for it.
+// Responses whose request is no longer pending (for example because it timed out) are discarded.
 func (c *Client) handleRequest(message []byte) {
 	var res ClientResponse
 	c.unmarshalMessage(message, &res)
-	c.requestChan <- &res
+	c.pending.resolve(&res, message)
 }
 
 func (c *Client) unmarshalMessage(message []byte, v any) {
 	if err := json.Unmarshal(message, v); err != nil {
-		if c.errChan == nil {
-			c.errChan = make(chan error)
-		}
-		c.errChan <- err
+		c.reportError(slog.LevelError, "failed to decode message", err, "message", string(message))
+	}
+}
+
+// reportError logs the error and passes it to the OnError handler.
+// The error is not passed to the handler if its buffer is full, so the goroutine reading messages never blocks.
+func (c *Client) reportError(level slog.Level, msg string, err error, args ...any) {
+	c.cfg.log().Log(context.Background(), level, msg, append(args, "error", err)...)
+	select {
+	case c.errChan <- err:
+	default:
 	}
 }
 
@@ -736,437 +540,108 @@ func (c *Client) handleStream(t streamtypes.Type, message []byte) {
 	case streamtypes.LedgerStreamType:
 		var ledger streamtypes.LedgerStream
 		c.unmarshalMessage(message, &ledger)
+		c.txWaiters.closeLedger(ledger.LedgerIndex.Uint32())
 
-		if c.ledgerClosedChan != nil {
-			c.ledgerClosedChan <- &ledger
+		// Ledgers received only because of a transaction waiter are not delivered.
+		if c.ledgerClosedChan != nil && (!c.txWaiters.active() || c.subscriptions.hasStream(ledgerStream)) {
+			deliverStream(c, c.ledgerClosedChan, ledgerStream, &ledger)
 		}
 	case streamtypes.TransactionStreamType:
 		var transactionStream streamtypes.TransactionStream
 		c.unmarshalMessage(message, &transactionStream)
-		if c.transactionChan != nil {
-			c.transactionChan <- &transactionStream
+		c.txWaiters.resolve(&transactionStream, message)
+		toBooks, toTransactions := c.subscriptions.routeTransaction(&transactionStream)
+		if toTransactions && c.txWaiters.active() && !c.subscriptions.coversTransaction(&transactionStream) {
+			// Transactions received only because of a transaction waiter are not delivered.
+			toTransactions = false
+		}
+		if toBooks && c.orderBookChan != nil {
+			orderBook := streamtypes.OrderBookStream(transactionStream)
+			deliverStream(c, c.orderBookChan, booksStream, &orderBook)
+		}
+		if toTransactions && c.transactionChan != nil {
+			deliverStream(c, c.transactionChan, transactionsStream, &transactionStream)
 		}
 	case streamtypes.ValidationStreamType:
 		var validation streamtypes.ValidationStream
 		c.unmarshalMessage(message, &validation)
 		if c.validationChan != nil {
-			c.validationChan <- &validation
+			deliverStream(c, c.validationChan, validationsStream, &validation)
 		}
 	case streamtypes.PeerStatusStreamType:
 		var peerStatus streamtypes.PeerStatusStream
 		c.unmarshalMessage(message, &peerStatus)
 		if c.peerStatusChan != nil {
-			c.peerStatusChan <- &peerStatus
+			deliverStream(c, c.peerStatusChan, peerStatusStream, &peerStatus)
 		}
 	case streamtypes.ConsensusStreamType:
 		var consensus streamtypes.ConsensusStream
 		c.unmarshalMessage(message, &consensus)
 		if c.consensusChan != nil {
-			c.consensusChan <- &consensus
+			deliverStream(c, c.consensusChan, consensusStream, &consensus)
 		}
-	default:
-		if c.errChan == nil {
-			c.errChan = make(chan error)
+	case streamtypes.BookChangesStreamType:
+		var bookChanges streamtypes.BookChangesStream
+		c.unmarshalMessage(message, &bookChanges)
+		if c.bookChangesChan != nil {
+			deliverStream(c, c.bookChangesChan, bookChangesStream, &bookChanges)
 		}
-		c.errChan <- ErrUnknownStreamType{
-			Type: t,
+	case streamtypes.ServerStreamType:
+		var server streamtypes.ServerStream
+		c.unmarshalMessage(message, &server)
+		if c.cfg.loadFactorThrottling {
+			c.limiter.SetLoadFactor(ratelimit.LoadFactorFromServerStream(&server))
 		}
+		if c.serverChan != nil {
+			deliverStream(c, c.serverChan, serverStream, &server)
+		}
+	case streamtypes.ManifestStreamType:
+		var manifest streamtypes.ManifestStream
+		c.unmarshalMessage(message, &manifest)
+		if c.manifestChan != nil {
+			deliverStream(c, c.manifestChan, manifestsStream, &manifest)
+		}
+	default:
+		c.reportError(slog.LevelWarn, "received unknown stream type", ErrUnknownStreamType{Type: t}, "type", t)
 	}
 }
 
 func (c *Client) readMessages() {
-	retryCount := 0
-	maxRetries := c.cfg.maxReconnects
-
 	for {
 		if c.conn == nil {
 			return
 		}
 		message, err := c.conn.ReadMessage()
 		switch {
-		case ws.IsCloseError(err) || ws.IsUnexpectedCloseError(err):
-			if retryCount >= maxRetries {
-				if c.errChan == nil {
-					c.errChan = make(chan error)
-				}
-				c.errChan <- ErrMaxReconnectionAttemptsReached{
-					Attempts: maxRetries,
-				}
-				return
-			}
-			retryCount++
-			connErr := c.conn.Connect()
-			if connErr != nil {
-				if c.errChan == nil {
-					c.errChan = make(chan error)
+		case c.closed.Load():
+			// The connection was closed by Disconnect.
+			c.pending.failAll()
+			return
+		case ws.IsCloseError(err) || ws.IsUnexpectedCloseError(err) || isReadTimeout(err):
+			// The connection was closed or stopped answering keepalive pings.
+			// Responses to requests sent on it will never arrive.
+			c.pending.failAll()
+			c.cfg.log().Warn("connection lost, reconnecting", "host", c.cfg.host, "error", err)
+			attempts, ok := c.reconnect()
+			if !ok {
+				if c.closed.Load() {
+					return
 				}
-				c.errChan <- connErr
+				c.reportError(slog.LevelError, "failed to reconnect", ErrMaxReconnectionAttemptsReached{
+					Attempts: attempts,
+				}, "attempts", attempts)
 				return
 			}
+			// The host may now be served by another server.
+			c.clio.Reset()
+			go c.resubscribe(attempts)
 		case err != nil:
-			c.errChan <- err
+			c.pending.failAll()
+			c.reportError(slog.LevelError, "failed to read message", err)
 			return
 		default:
 			// Send the message to the channel
 			c.handleMessage(message)
-			// Reset retry count on successful message
-			retryCount = 0
-		}
-	}
-}
-
-// getSignedTx ensures the transaction is fully signed and returns the transaction blob.
-// If the transaction is already signed, it encodes and returns it. Otherwise, it autofills (if enabled)
-// and signs the transaction using the provided wallet.
-func (c *Client) getSignedTx(tx transaction.FlatTransaction, autofill bool, wallet *wallet.Wallet) (string, error) {
-	// Check if the transaction is already signed: both fields must be non-empty.
-	sig, sigOk := tx["TxSignature"].(string)
-	pubKey, pubKeyOk := tx["SigningPubKey"].(string)
-	if sigOk && sig != "" && pubKeyOk && pubKey != "" {
-		blob, err := binarycodec.Encode(tx)
-		if err != nil {
-			return "", err
-		}
-		return blob, nil
-	}
-
-	// If not signed, ensure a wallet is provided.
-	if wallet == nil {
-		return "", ErrMissingWallet
-	}
-
-	// Optionally autofill the transaction.
-	if autofill {
-		if err := c.Autofill(&tx); err != nil {
-			return "", err
-		}
-	}
-
-	// Sign the transaction.
-	txBlob, _, err := wallet.Sign(tx)
-	if err != nil {
-		return "", err
-	}
-	return txBlob, nil
-}
-
-// fetchOwnerReserveFee fetches the owner reserve fee from the server state.
-// Replicates the JavaScript fetchOwnerReserveFee function.
-func (c *Client) fetchOwnerReserveFee() (uint64, error) {
-	response, err := c.GetServerState(&server.StateRequest{})
-	if err != nil {
-		return 0, err
-	}
-
-	reserveInc := response.State.ValidatedLedger.ReserveInc
-	if reserveInc == 0 {
-		return 0, ErrCouldNotFetchOwnerReserve
-	}
-
-	return uint64(reserveInc), nil
-}
-
-// fetchCounterPartySignersCount fetches the number of signers for the counterparty account.
-// For LoanSet transactions, if Counterparty is not provided, it fetches the LoanBroker and uses its Owner.
-// Returns the number of signers in the counterparty's signer list, or 1 if no signer list exists.
-func (c *Client) fetchCounterPartySignersCount(tx transaction.FlatTransaction) (uint64, error) {
-	var counterparty types.Address
-
-	// Extract Counterparty from transaction if present
-	if cp, ok := tx["Counterparty"]; ok {
-		if cpStr, ok := cp.(string); ok && cpStr != "" {
-			counterparty = types.Address(cpStr)
-		}
-	}
-
-	// If Counterparty is not provided and transaction has LoanBrokerID, fetch LoanBroker
-	if counterparty == "" {
-		loanBrokerID, ok := tx["LoanBrokerID"].(string)
-		if !ok || loanBrokerID == "" {
-			return 0, ErrLoanBrokerIDRequired
-		}
-
-		// Make ledger_entry request
-		res, err := c.GetLedgerEntry(&ledger.EntryRequest{
-			Index:       loanBrokerID,
-			LedgerIndex: common.LedgerTitle("validated"),
-		})
-		if err != nil {
-			return 0, err
-		}
-
-		// Extract Owner from the LoanBroker FlatLedgerObject
-		owner, ok := res.Node["Owner"].(string)
-		if !ok || owner == "" {
-			return 0, ErrCouldNotFetchLoanBrokerOwner
-		}
-		counterparty = types.Address(owner)
-	}
-
-	if counterparty == "" {
-		return 0, ErrCounterpartyRequired
-	}
-
-	// Fetch account info with signer lists
-	accountInfo, err := c.GetAccountInfo(&account.InfoRequest{
-		Account:     counterparty,
-		LedgerIndex: common.LedgerTitle("validated"),
-		SignerLists: true,
-	})
-	if err != nil {
-		return 0, err
-	}
-
-	// Extract the first signer list's SignerEntries length
-	if len(accountInfo.SignerLists) > 0 {
-		return uint64(len(accountInfo.SignerLists[0].SignerEntries)), nil
-	}
-
-	// Default to 1 if no signer list exists
-	return 1, nil
-}
-
-// calculateBatchFees calculates the total fees for all inner transactions in a Batch.
-// Replicates the JavaScript logic for Batch transaction fee calculation.
-func (c *Client) calculateBatchFees(tx *transaction.FlatTransaction) (uint64, error) {
-	var totalFees uint64
-
-	// Get RawTransactions from the batch transaction
-	rawTransactions, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return 0, ErrRawTransactionsFieldMissing
-	}
-
-	// Iterate through each raw transaction
-	for _, rawTx := range rawTransactions {
-		// Extract the actual transaction from the wrapper
-		innerTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return 0, ErrRawTransactionFieldMissing
-		}
-
-		// Calculate fee for this inner transaction (no multi-signing for inner transactions)
-		innerTxFlat := transaction.FlatTransaction(innerTx)
-		err := c.calculateFeePerTransactionType(&innerTxFlat, 0)
-		if err != nil {
-			return 0, err
-		}
-
-		// Extract the calculated fee
-		feeStr, ok := innerTx["Fee"].(string)
-		if !ok {
-			return 0, ErrFeeFieldMissing
-		}
-
-		innerTx["Fee"] = "0"
-
-		// Convert fee string to uint64 and add to total
-		feeUint, err := strconv.ParseUint(feeStr, 10, 64)
-		if err != nil {
-			return 0, ErrFailedToParseFee{
-				Fee: feeStr,
-				Err: err,
-			}
-		}
-
-		totalFees += feeUint
-	}
-
-	return totalFees, nil
-}
-
-func (c *Client) autofillRawTransactions(tx *transaction.FlatTransaction) error {
-	needsNetworkID, err := c.txNeedsNetworkID()
-	if err != nil {
-		return err
-	}
-
-	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return ErrRawTransactionsFieldIsNotAnArray
-	}
-
-	accountSeq := make(map[string]uint32, len(rawTxs))
-
-	for _, rawTx := range rawTxs {
-		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return ErrRawTransactionFieldIsNotAnObject
-		}
-
-		// Validate `Fee` field
-		if innerRawTx["Fee"] == nil {
-			innerRawTx["Fee"] = "0"
-		} else if innerRawTx["Fee"] != "0" {
-			return types.ErrBatchInnerTransactionInvalid
-		}
-
-		// Validate `SigningPubKey` field
-		if innerRawTx["SigningPubKey"] == nil {
-			innerRawTx["SigningPubKey"] = ""
-		} else if innerRawTx["SigningPubKey"] != "" {
-			return ErrSigningPubKeyFieldMustBeEmpty
-		}
-
-		// Validate `TxnSignature` field
-		if innerRawTx["TxnSignature"] != nil {
-			return ErrTxnSignatureFieldMustBeEmpty
-		}
-		if innerRawTx["Signers"] != nil {
-			return ErrSignersFieldMustBeEmpty
-		}
-
-		// Validate `NetworkID` field
-		if innerRawTx["NetworkID"] == nil && needsNetworkID {
-			innerRawTx["NetworkID"] = c.NetworkID
-		}
-
-		// Validate `Sequence` field
-		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
-
-			acc, ok := innerRawTx["Account"].(string)
-			if !ok {
-				return ErrAccountFieldIsNotAString
-			}
-
-			if accountSeq[acc] != 0 {
-				innerRawTx["Sequence"] = accountSeq[acc]
-				accountSeq[acc]++
-			} else {
-				accountInfo, err := c.GetAccountInfo(&account.InfoRequest{
-					Account: types.Address(acc),
-				})
-				if err != nil {
-					return err
-				}
-				var seq uint32
-				if innerRawTx["Account"] == (*tx)["Account"] {
-					seq = accountInfo.AccountData.Sequence + 1
-				} else {
-					seq = accountInfo.AccountData.Sequence
-				}
-				accountSeq[acc] = seq + 1
-				innerRawTx["Sequence"] = seq
-			}
-		}
-	}
-
-	return nil
-}
-
-// isNotLaterRippledVersion determines whether the source rippled version is not later than the target rippled version.
-// Example usage: isNotLaterRippledVersion("1.10.0", "1.11.0") returns true.
-//
-//	isNotLaterRippledVersion("1.10.0", "1.10.0-b1") returns false.
-func isNotLaterRippledVersion(source, target string) bool {
-	if source == target {
-		return true
-	}
-
-	sourceDecomp := strings.Split(source, ".")
-	targetDecomp := strings.Split(target, ".")
-
-	if len(sourceDecomp) < 3 || len(targetDecomp) < 3 {
-		return false
-	}
-
-	sourceMajor, err := strconv.Atoi(sourceDecomp[0])
-	if err != nil {
-		return false
-	}
-	sourceMinor, err := strconv.Atoi(sourceDecomp[1])
-	if err != nil {
-		return false
-	}
-	targetMajor, err := strconv.Atoi(targetDecomp[0])
-	if err != nil {
-		return false
-	}
-	targetMinor, err := strconv.Atoi(targetDecomp[1])
-	if err != nil {
-		return false
-	}
-
-	// Compare major version
-	if sourceMajor != targetMajor {
-		return sourceMajor < targetMajor
-	}
-
-	// Compare minor version
-	if sourceMinor != targetMinor {
-		return sourceMinor < targetMinor
-	}
-
-	sourcePatch := strings.Split(sourceDecomp[2], "-")
-	targetPatch := strings.Split(targetDecomp[2], "-")
-
-	sourcePatchVersion, err := strconv.Atoi(sourcePatch[0])
-	if err != nil {
-		return false
-	}
-	targetPatchVersion, err := strconv.Atoi(targetPatch[0])
-	if err != nil {
-		return false
-	}
-
-	// Compare patch version
-	if sourcePatchVersion != targetPatchVersion {
-		return sourcePatchVersion < targetPatchVersion
-	}
-
-	// Compare release version
-	if len(sourcePatch) != len(targetPatch) {
-		return len(sourcePatch) > len(targetPatch)
-	}
-
-	if len(sourcePatch) == 2 {
-		// Compare different release types
-		if !strings.HasPrefix(sourcePatch[1], string(targetPatch[1][0])) {
-			return sourcePatch[1] < targetPatch[1]
-		}
-
-		// Compare beta version
-		if strings.HasPrefix(sourcePatch[1], "b") {
-			sourceBeta, err := strconv.Atoi(sourcePatch[1][1:])
-			if err != nil {
-				return false
-			}
-			targetBeta, err := strconv.Atoi(targetPatch[1][1:])
-			if err != nil {
-				return false
-			}
-			return sourceBeta < targetBeta
-		}
-
-		// Compare rc version
-		if strings.HasPrefix(sourcePatch[1], "rc") {
-			sourceRC, err := strconv.Atoi(sourcePatch[1][2:])
-			if err != nil {
-				return false
-			}
-			targetRC, err := strconv.Atoi(targetPatch[1][2:])
-			if err != nil {
-				return false
-			}
-			return sourceRC < targetRC
-		}
-	}
-
-	return false
-}
-
-// txNeedsNetworkID determines if the transaction required a networkID to be valid.
-// Transaction needs networkID if later than restricted ID and build version is >= 1.11.0
-func (c *Client) txNeedsNetworkID() (bool, error) {
-	if c.NetworkID != 0 && c.NetworkID > RestrictedNetworks {
-		res, err := c.GetServerInfo(&server.InfoRequest{})
-		if err != nil {
-			return false, err
-		}
-
-		if res.Info.BuildVersion != "" {
-			return isNotLaterRippledVersion(RequiredNetworkIDVersion, res.Info.BuildVersion), nil
 		}
 	}
-	return false, nil
 }
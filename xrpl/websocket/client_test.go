@@ -1,13 +1,27 @@
 package websocket
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"log/slog"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/Peersyst/xrpl-go/xrpl"
 	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	ledgerqueries "github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/ratelimit"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/Peersyst/xrpl-go/xrpl/websocket/interfaces"
@@ -151,8 +165,8 @@ func TestClient_SendRequest(t *testing.T) {
 					"validated":    true,
 				},
 			},
-			expectedErr: &ErrorWebsocketClientXrplResponse{
-				Type: "invalidParams",
+			expectedErr: &xrpl.XRPLError{
+				Token: "invalidParams",
 				Request: map[string]any{
 					"account": "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
 				},
@@ -167,6 +181,29 @@ func TestClient_SendRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "error response with code and message",
+			req: &account.ChannelsRequest{
+				Account: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
+			},
+			expectedErr: &xrpl.XRPLError{
+				Token:   "actNotFound",
+				Message: "Account not found.",
+			},
+			serverMessages: []map[string]any{
+				{
+					"id":            1,
+					"status":        "error",
+					"error":         "actNotFound",
+					"error_code":    19,
+					"error_message": "Account not found.",
+					"request": map[string]any{
+						"account": "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
+						"command": "account_channels",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tt {
@@ -186,6 +223,124 @@ func TestClient_SendRequest(t *testing.T) {
 	}
 }
 
+func TestClient_RequestContext(t *testing.T) {
+	t.Run("cancelled context aborts the request", func(t *testing.T) {
+		cl, cleanup := setupTestClient(t, []map[string]any{})
+		defer cleanup()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := cl.RequestContext(ctx, &account.ChannelsRequest{
+			Account: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
+		})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("context deadline is shorter than the client timeout", func(t *testing.T) {
+		cl, cleanup := setupTestClient(t, []map[string]any{})
+		defer cleanup()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := cl.RequestContext(ctx, &account.ChannelsRequest{
+			Account: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+}
+
+func TestClient_ConcurrentRequests(t *testing.T) {
+	t.Run("responses are matched to requests by ID", func(t *testing.T) {
+		ws := &testutil.MockWebSocketServer{}
+		s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+			// Read both requests before answering them in reverse order.
+			ids := make([]int, 0, 2)
+			for range 2 {
+				var req map[string]any
+				if err := c.ReadJSON(&req); err != nil {
+					return
+				}
+				ids = append(ids, int(req["id"].(float64)))
+			}
+			for i := len(ids) - 1; i >= 0; i-- {
+				err := c.WriteJSON(map[string]any{
+					"id":     ids[i],
+					"result": map[string]any{"account": "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", "ledger_index": ids[i]},
+				})
+				if err != nil {
+					t.Errorf("error writing message: %v", err)
+				}
+			}
+		})
+		defer s.Close()
+
+		url, _ := testutil.ConvertHTTPToWS(s.URL)
+		cl := NewClient(NewClientConfig().WithHost(url).WithTimeout(1 * time.Second))
+		require.NoError(t, cl.Connect())
+		defer cl.Disconnect()
+
+		var wg sync.WaitGroup
+		results := make([]*ClientResponse, 2)
+		errs := make([]error, 2)
+		for i := range 2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], errs[i] = cl.Request(&account.ChannelsRequest{
+					Account: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
+				})
+			}()
+		}
+		wg.Wait()
+
+		for i := range 2 {
+			require.NoError(t, errs[i])
+			require.Equal(t, float64(results[i].ID), results[i].Result["ledger_index"])
+		}
+		require.NotEqual(t, results[0].ID, results[1].ID)
+		require.Equal(t, 0, cl.pending.len())
+	})
+
+	t.Run("in-flight cap blocks requests until a slot is freed", func(t *testing.T) {
+		ws := &testutil.MockWebSocketServer{}
+		s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+			// Never answer, so the first request keeps its slot.
+			for {
+				if _, _, err := c.ReadMessage(); err != nil {
+					return
+				}
+			}
+		})
+		defer s.Close()
+
+		url, _ := testutil.ConvertHTTPToWS(s.URL)
+		cl := NewClient(NewClientConfig().
+			WithHost(url).
+			WithTimeout(1 * time.Second).
+			WithMaxInFlightRequests(1))
+		require.NoError(t, cl.Connect())
+		defer cl.Disconnect()
+
+		go func() {
+			_, _ = cl.Request(&account.ChannelsRequest{Account: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"})
+		}()
+		require.Eventually(t, func() bool { return cl.pending.len() == 1 }, time.Second, 5*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := cl.RequestContext(ctx, &account.ChannelsRequest{
+			Account: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, 1, cl.pending.len())
+	})
+}
+
 func TestClient_formatRequest(t *testing.T) {
 	ws := &Client{}
 	tt := []struct {
@@ -237,6 +392,58 @@ func TestClient_formatRequest(t *testing.T) {
 			}`,
 			expectedErr: nil,
 		},
+		{
+			description: "ledger entry request with a claim ID selector",
+			req: &ledgerqueries.EntryRequest{
+				XChainOwnedClaimID: &ledgertypes.XChainOwnedClaimIDSelector{
+					LockingChainDoor:   "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+					LockingChainIssue:  ledger.Asset{Currency: "XRP"},
+					IssuingChainDoor:   "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					IssuingChainIssue:  ledger.Asset{Currency: "XRP"},
+					XChainOwnedClaimID: 4,
+				},
+			},
+			id: 1,
+			expected: `{
+				"id": 1,
+				"BaseRequest": {},
+				"api_version": 2,
+				"command": "ledger_entry",
+				"xchain_owned_claim_id": {
+					"LockingChainDoor": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+					"LockingChainIssue": {"currency": "XRP"},
+					"IssuingChainDoor": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"IssuingChainIssue": {"currency": "XRP"},
+					"xchain_owned_claim_id": 4
+				}
+			}`,
+		},
+		{
+			description: "ledger entry request with a bridge selector",
+			req: &ledgerqueries.EntryRequest{
+				BridgeAccount: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				Bridge: &ledger.XChainBridge{
+					LockingChainDoor:  "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+					LockingChainIssue: ledger.Asset{Currency: "XRP"},
+					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					IssuingChainIssue: ledger.Asset{Currency: "XRP"},
+				},
+			},
+			id: 1,
+			expected: `{
+				"id": 1,
+				"BaseRequest": {},
+				"api_version": 2,
+				"command": "ledger_entry",
+				"bridge_account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+				"bridge": {
+					"LockingChainDoor": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+					"LockingChainIssue": {"currency": "XRP"},
+					"IssuingChainDoor": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"IssuingChainIssue": {"currency": "XRP"}
+				}
+			}`,
+		},
 	}
 
 	for _, tc := range tt {
@@ -253,161 +460,6 @@ func TestClient_formatRequest(t *testing.T) {
 	}
 }
 
-func TestClient_convertTransactionAddressToClassicAddress(t *testing.T) {
-	ws := &Client{}
-	tests := []struct {
-		name      string
-		tx        transaction.FlatTransaction
-		fieldName string
-		expected  transaction.FlatTransaction
-	}{
-		{
-			name: "No conversion for classic address",
-			tx: transaction.FlatTransaction{
-				"Destination": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-			},
-			fieldName: "Destination",
-			expected: transaction.FlatTransaction{
-				"Destination": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-			},
-		},
-		{
-			name: "Field not present in transaction",
-			tx: transaction.FlatTransaction{
-				"Amount": "1000000",
-			},
-			fieldName: "Destination",
-			expected: transaction.FlatTransaction{
-				"Amount": "1000000",
-			},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			ws.convertTransactionAddressToClassicAddress(&tt.tx, tt.fieldName)
-			if reflect.DeepEqual(tt.expected, &tt.tx) {
-				t.Errorf("expected %+v, result %+v", tt.expected, &tt.tx)
-			}
-		})
-	}
-}
-
-func TestClient_validateTransactionAddress(t *testing.T) {
-	ws := &Client{}
-	tests := []struct {
-		name         string
-		tx           transaction.FlatTransaction
-		addressField string
-		tagField     string
-		expected     transaction.FlatTransaction
-		expectedErr  error
-	}{
-		{
-			name: "Valid classic address without tag",
-			tx: transaction.FlatTransaction{
-				"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-			},
-			addressField: "Account",
-			tagField:     "SourceTag",
-			expected: transaction.FlatTransaction{
-				"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-			},
-			expectedErr: nil,
-		},
-		{
-			name: "Valid classic address with tag",
-			tx: transaction.FlatTransaction{
-				"Destination":    "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-				"DestinationTag": uint32(12345),
-			},
-			addressField: "Destination",
-			tagField:     "DestinationTag",
-			expected: transaction.FlatTransaction{
-				"Destination":    "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-				"DestinationTag": uint32(12345),
-			},
-			expectedErr: nil,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := ws.validateTransactionAddress(&tt.tx, tt.addressField, tt.tagField)
-
-			if tt.expectedErr != nil {
-				if !errors.Is(err, tt.expectedErr) {
-					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
-				}
-			} else if err != nil {
-				t.Errorf("Unexpected error: %v", err)
-			}
-
-			if !reflect.DeepEqual(tt.expected, tt.tx) {
-				t.Errorf("Expected %v, but got %v", tt.expected, tt.tx)
-			}
-		})
-	}
-}
-
-func TestClient_setValidTransactionAddresses(t *testing.T) {
-	tests := []struct {
-		name        string
-		tx          transaction.FlatTransaction
-		expected    transaction.FlatTransaction
-		expectedErr error
-	}{
-		{
-			name: "Valid transaction with classic addresses",
-			tx: transaction.FlatTransaction{
-				"Account":     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
-			},
-			expected: transaction.FlatTransaction{
-				"Account":     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
-			},
-			expectedErr: nil,
-		},
-		{
-			name: "Transaction with additional address fields",
-			tx: transaction.FlatTransaction{
-				"Account":     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
-				"Owner":       "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-				"RegularKey":  "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-			},
-			expected: transaction.FlatTransaction{
-				"Account":     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
-				"Owner":       "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-				"RegularKey":  "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
-			},
-			expectedErr: nil,
-		},
-	}
-
-	ws := &Client{}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := ws.setValidTransactionAddresses(&tt.tx)
-
-			if tt.expectedErr != nil {
-				if !errors.Is(err, tt.expectedErr) {
-					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
-				}
-			} else if err != nil {
-				t.Errorf("Unexpected error: %v", err)
-			}
-
-			if !reflect.DeepEqual(tt.expected, tt.tx) {
-				t.Errorf("Expected %v, but got %v", tt.expected, tt.tx)
-			}
-		})
-	}
-}
-
 func TestClient_setTransactionNextValidSequenceNumber(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -452,7 +504,7 @@ func TestClient_setTransactionNextValidSequenceNumber(t *testing.T) {
 			cl, cleanup := setupTestClient(t, tt.serverMessages)
 			defer cleanup()
 
-			err := cl.setTransactionNextValidSequenceNumber(&tt.tx)
+			err := cl.eng.SetNextValidSequenceNumber(context.Background(), &tt.tx)
 
 			if tt.expectedErr != nil {
 				if !reflect.DeepEqual(err.Error(), tt.expectedErr.Error()) {
@@ -846,8 +898,10 @@ func TestClient_calculateFeePerTransactionType(t *testing.T) {
 
 			cl.cfg.feeCushion = tt.feeCushion
 			cl.cfg.maxFeeXRP = DefaultMaxFeeXRP
+			// The engine reads the settings when the client is created.
+			cl.eng = newEngine(cl)
 
-			err := cl.calculateFeePerTransactionType(&tt.tx, tt.nSigners)
+			err := cl.eng.CalculateFee(context.Background(), &tt.tx, tt.nSigners)
 
 			if tt.expectedErr != nil {
 				if !reflect.DeepEqual(err.Error(), tt.expectedErr.Error()) {
@@ -894,7 +948,7 @@ func TestClient_setLastLedgerSequence(t *testing.T) {
 			cl, cleanup := setupTestClient(t, tt.serverMessages)
 			defer cleanup()
 
-			err := cl.setLastLedgerSequence(&tt.tx)
+			err := cl.eng.SetLastLedgerSequence(context.Background(), &tt.tx)
 
 			if tt.expectedErr != nil {
 				if err == nil || err.Error() != tt.expectedErr.Error() {
@@ -943,6 +997,9 @@ func TestClient_checkAccountDeleteBlockers(t *testing.T) {
 			ws := &testutil.MockWebSocketServer{Msgs: tt.serverMessages}
 			s := ws.TestWebSocketServer(func(c *websocket.Conn) {
 				for _, m := range tt.serverMessages {
+					if _, _, err := c.ReadMessage(); err != nil {
+						return
+					}
 					err := c.WriteJSON(m)
 					if err != nil {
 						t.Errorf("error writing message: %v", err)
@@ -958,7 +1015,7 @@ func TestClient_checkAccountDeleteBlockers(t *testing.T) {
 				t.Errorf("Error connecting to server: %v", err)
 			}
 
-			err := cl.checkAccountDeleteBlockers(tt.address)
+			err := cl.eng.CheckAccountDeleteBlockers(context.Background(), tt.address)
 
 			if tt.expectedErr != nil {
 				if err == nil || err.Error() != tt.expectedErr.Error() {
@@ -973,61 +1030,6 @@ func TestClient_checkAccountDeleteBlockers(t *testing.T) {
 	}
 }
 
-func TestClient_setTransactionFlags(t *testing.T) {
-	tests := []struct {
-		name     string
-		tx       transaction.FlatTransaction
-		expected uint32
-		wantErr  bool
-	}{
-		{
-			name: "No flags set",
-			tx: transaction.FlatTransaction{
-				"TransactionType": string(transaction.PaymentTx),
-			},
-			expected: uint32(0),
-			wantErr:  false,
-		},
-		{
-			name: "Flags already set",
-			tx: transaction.FlatTransaction{
-				"TransactionType": string(transaction.PaymentTx),
-				"Flags":           uint32(1),
-			},
-			expected: 1,
-			wantErr:  false,
-		},
-		{
-			name: "Missing TransactionType",
-			tx: transaction.FlatTransaction{
-				"Flags": uint32(1),
-			},
-			expected: 0,
-			wantErr:  true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			c := &Client{}
-			err := c.setTransactionFlags(&tt.tx)
-
-			if (err != nil) != tt.wantErr {
-
-				t.Errorf("setTransactionFlags() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-
-			if !tt.wantErr {
-				flags, ok := tt.tx["Flags"]
-				if !ok && tt.expected != 0 {
-					t.Errorf("setTransactionFlags() got = %v (type %T), want %v (type %T)", flags, flags, tt.expected, tt.expected)
-				}
-			}
-		})
-	}
-}
-
 func TestClient_autofillRawTransactions(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1560,7 +1562,7 @@ func TestClient_autofillRawTransactions(t *testing.T) {
 			},
 			networkID:   0,
 			expectedTx:  transaction.FlatTransaction{},
-			expectedErr: &ErrorWebsocketClientXrplResponse{Type: "actNotFound"},
+			expectedErr: &xrpl.XRPLError{Token: "actNotFound"},
 		},
 	}
 
@@ -1578,7 +1580,7 @@ func TestClient_autofillRawTransactions(t *testing.T) {
 				originalTx[k] = v
 			}
 
-			err := cl.autofillRawTransactions(&tt.tx)
+			err := cl.eng.AutofillRawTransactions(context.Background(), &tt.tx)
 
 			if tt.expectedErr != nil {
 				if err == nil {
@@ -1588,13 +1590,13 @@ func TestClient_autofillRawTransactions(t *testing.T) {
 
 				// Check error type and message
 				switch expectedErr := tt.expectedErr.(type) {
-				case *ErrorWebsocketClientXrplResponse:
-					if wsErr, ok := err.(*ErrorWebsocketClientXrplResponse); ok {
-						if wsErr.Type != expectedErr.Type {
-							t.Errorf("Expected error type %v, but got %v", expectedErr.Type, wsErr.Type)
+				case *xrpl.XRPLError:
+					if xrplErr, ok := err.(*xrpl.XRPLError); ok {
+						if xrplErr.Token != expectedErr.Token {
+							t.Errorf("Expected error token %v, but got %v", expectedErr.Token, xrplErr.Token)
 						}
 					} else {
-						t.Errorf("Expected ErrorWebsocketClientXrplResponse, but got %T", err)
+						t.Errorf("Expected XRPLError, but got %T", err)
 					}
 				default:
 					if err.Error() != tt.expectedErr.Error() {
@@ -1632,7 +1634,11 @@ func TestClient_autofillRawTransactions(t *testing.T) {
 func setupTestClientForAutofill(t *testing.T, serverMessages []map[string]any) (*Client, func()) {
 	ws := &testutil.MockWebSocketServer{Msgs: serverMessages}
 	s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+		// Answer each request with the next message, so responses never arrive before the request is sent.
 		for _, m := range serverMessages {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
 			err := c.WriteJSON(m)
 			if err != nil {
 				t.Errorf("error writing message: %v", err)
@@ -1652,3 +1658,296 @@ func setupTestClientForAutofill(t *testing.T, serverMessages []map[string]any) (
 		s.Close()
 	}
 }
+
+func TestClient_handleStream(t *testing.T) {
+	offerTx := `{
+		"type": "transaction",
+		"hash": "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+		"meta": {"AffectedNodes": [{"CreatedNode": {"LedgerEntryType": "Offer", "NewFields": {
+			"TakerGets": "1000000",
+			"TakerPays": {"currency": "USD", "issuer": "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", "value": "1"}
+		}}}]},
+		"validated": true
+	}`
+	paymentTx := `{
+		"type": "transaction",
+		"hash": "E08D6E9754025BA2534A78707605E0601F03ACE063687A0CA1BDDACFCD1698C7",
+		"meta": {"AffectedNodes": [{"ModifiedNode": {"LedgerEntryType": "AccountRoot", "FinalFields": {}}}]},
+		"validated": true
+	}`
+	usdBook := &subscribe.Request{Books: []streamtypes.OrderBook{{
+		TakerGets: types.IssuedCurrencyAmount{Currency: "XRP"},
+		TakerPays: types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"},
+	}}}
+
+	tests := []struct {
+		name                string
+		subscriptions       []*subscribe.Request
+		streamType          streamtypes.Type
+		message             string
+		expectedTransaction bool
+		expectedOrderBook   bool
+		expectedBookChanges bool
+		expectedServer      bool
+		expectedManifest    bool
+	}{
+		{
+			name:              "transaction in a subscribed book goes to the order book handler",
+			subscriptions:     []*subscribe.Request{usdBook},
+			streamType:        streamtypes.TransactionStreamType,
+			message:           offerTx,
+			expectedOrderBook: true,
+		},
+		{
+			name:                "transaction in a subscribed book also goes to the transactions handler when subscribed to transactions",
+			subscriptions:       []*subscribe.Request{usdBook, {Streams: []string{"transactions"}}},
+			streamType:          streamtypes.TransactionStreamType,
+			message:             offerTx,
+			expectedOrderBook:   true,
+			expectedTransaction: true,
+		},
+		{
+			name:                "transaction outside subscribed books goes to the transactions handler",
+			subscriptions:       []*subscribe.Request{usdBook},
+			streamType:          streamtypes.TransactionStreamType,
+			message:             paymentTx,
+			expectedTransaction: true,
+		},
+		{
+			name:                "book changes",
+			streamType:          streamtypes.BookChangesStreamType,
+			message:             `{"type": "bookChanges", "ledger_index": 88530953, "changes": []}`,
+			expectedBookChanges: true,
+		},
+		{
+			name:           "server status",
+			streamType:     streamtypes.ServerStreamType,
+			message:        `{"type": "serverStatus", "base_fee": 10, "load_base": 256, "load_factor": 256, "server_status": "full"}`,
+			expectedServer: true,
+		},
+		{
+			name:             "manifest",
+			streamType:       streamtypes.ManifestStreamType,
+			message:          `{"type": "manifestReceived", "master_key": "nHUFCyRCrUjvtZmKiLeF8ReopzKuUoKeDeXo3wEUBVSaawzcSBpW", "seq": 3}`,
+			expectedManifest: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				transactionChan: make(chan *streamtypes.TransactionStream, 1),
+				orderBookChan:   make(chan *streamtypes.OrderBookStream, 1),
+				bookChangesChan: make(chan *streamtypes.BookChangesStream, 1),
+				serverChan:      make(chan *streamtypes.ServerStream, 1),
+				manifestChan:    make(chan *streamtypes.ManifestStream, 1),
+			}
+			for _, req := range tt.subscriptions {
+				c.subscriptions.add(req)
+			}
+
+			c.handleStream(tt.streamType, []byte(tt.message))
+
+			require.Len(t, c.transactionChan, boolToInt(tt.expectedTransaction))
+			require.Len(t, c.orderBookChan, boolToInt(tt.expectedOrderBook))
+			require.Len(t, c.bookChangesChan, boolToInt(tt.expectedBookChanges))
+			require.Len(t, c.serverChan, boolToInt(tt.expectedServer))
+			require.Len(t, c.manifestChan, boolToInt(tt.expectedManifest))
+		})
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestClient_reportError(t *testing.T) {
+	tests := []struct {
+		name          string
+		message       string
+		expectedMsg   string
+		expectedLevel string
+		expectedErr   error
+	}{
+		{
+			name:          "malformed message",
+			message:       `{"type": `,
+			expectedMsg:   "failed to decode message",
+			expectedLevel: "ERROR",
+		},
+		{
+			name:          "unknown stream type",
+			message:       `{"type": "unknown"}`,
+			expectedMsg:   "received unknown stream type",
+			expectedLevel: "WARN",
+			expectedErr:   ErrUnknownStreamType{Type: streamtypes.Type("unknown")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			c := &Client{
+				cfg:     NewClientConfig().WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))),
+				errChan: make(chan error, 1),
+			}
+
+			c.handleMessage([]byte(tt.message))
+
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(logs.Bytes(), &record))
+			require.Equal(t, tt.expectedMsg, record["msg"])
+			require.Equal(t, tt.expectedLevel, record["level"])
+			require.NotEmpty(t, record["error"])
+
+			err := <-c.errChan
+			if tt.expectedErr != nil {
+				require.Equal(t, tt.expectedErr, err)
+			}
+
+			// Without an OnError handler draining the buffer, further errors are dropped instead of blocking.
+			c.errChan <- errors.New("pending")
+			done := make(chan struct{})
+			go func() {
+				c.handleMessage([]byte(tt.message))
+				close(done)
+			}()
+			require.Eventually(t, func() bool {
+				select {
+				case <-done:
+					return true
+				default:
+					return false
+				}
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestClient_LoadFactorThrottling(t *testing.T) {
+	t.Run("server stream adapts the rate", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			throttling   bool
+			expectedRate float64
+		}{
+			{
+				name:         "throttling enabled",
+				throttling:   true,
+				expectedRate: 50,
+			},
+			{
+				name:         "throttling disabled",
+				throttling:   false,
+				expectedRate: 100,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				c := NewClient(NewClientConfig().WithRateLimit(100, 10).WithLoadFactorThrottling(tt.throttling))
+
+				c.handleStream(streamtypes.ServerStreamType, []byte(`{"type": "serverStatus", "load_base": 256, "load_factor": 512, "server_status": "full"}`))
+
+				require.Equal(t, tt.expectedRate, c.limiter.Rate())
+			})
+		}
+	})
+
+	t.Run("server_info response adapts the rate", func(t *testing.T) {
+		cl, cleanup := setupTestClient(t, []map[string]any{
+			{
+				"id":     1,
+				"status": "success",
+				"type":   "response",
+				"result": map[string]any{"info": map[string]any{"load_factor": 4}},
+			},
+		})
+		defer cleanup()
+
+		cl.cfg.loadFactorThrottling = true
+		cl.limiter = ratelimit.New(100, 10)
+
+		_, err := cl.GetServerInfo(&server.InfoRequest{})
+		require.NoError(t, err)
+		require.Equal(t, float64(25), cl.limiter.Rate())
+	})
+}
+
+func TestClient_Interceptors(t *testing.T) {
+	tests := []struct {
+		name          string
+		serverMessage map[string]any
+		expectedErr   error
+	}{
+		{
+			name: "pass - response",
+			serverMessage: map[string]any{
+				"id":     1,
+				"status": "success",
+				"type":   "response",
+				"result": map[string]any{"info": map[string]any{"build_version": "2.3.0"}},
+			},
+		},
+		{
+			name: "fail - error response",
+			serverMessage: map[string]any{
+				"id":     1,
+				"status": "error",
+				"type":   "response",
+				"error":  "tooBusy",
+			},
+			expectedErr: xrpl.ErrTooBusy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, []map[string]any{tt.serverMessage})
+			defer cleanup()
+
+			var requests []*xrpl.RequestEvent
+			var responses, failures []*xrpl.ResponseEvent
+			cl.cfg.interceptors = xrpl.Interceptors{{
+				BeforeRequest: func(ctx context.Context, req *xrpl.RequestEvent) context.Context {
+					requests = append(requests, req)
+					return ctx
+				},
+				AfterResponse: func(_ context.Context, res *xrpl.ResponseEvent) {
+					responses = append(responses, res)
+				},
+				OnError: func(_ context.Context, res *xrpl.ResponseEvent) {
+					failures = append(failures, res)
+				},
+			}}
+
+			_, err := cl.GetServerInfo(&server.InfoRequest{})
+
+			require.Len(t, requests, 1)
+			require.Equal(t, "server_info", requests[0].Method)
+			require.Equal(t, uint64(1), requests[0].ID)
+			var payload map[string]any
+			require.NoError(t, json.Unmarshal(requests[0].Payload, &payload))
+			require.Equal(t, "server_info", payload["command"])
+			require.Equal(t, float64(1), payload["id"])
+
+			events := responses
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Empty(t, responses)
+				events = failures
+			} else {
+				require.NoError(t, err)
+				require.Empty(t, failures)
+			}
+			require.Len(t, events, 1)
+			require.Same(t, requests[0], events[0].Request)
+			expectedPayload, _ := json.Marshal(tt.serverMessage)
+			require.JSONEq(t, string(expectedPayload), string(events[0].Payload))
+			require.ErrorIs(t, events[0].Err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package websocket
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/clio"
+	cliotypes "github.com/Peersyst/xrpl-go/xrpl/queries/clio/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetMPTHolders(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		expected       *clio.MPTHoldersResponse
+		expectedErr    error
+	}{
+		{
+			name: "pass - Clio server",
+			serverMessages: []map[string]any{
+				{"id": 1, "result": map[string]any{"info": map[string]any{"clio_version": "2.3.0"}}},
+				{
+					"id": 2,
+					"result": map[string]any{
+						"mpt_issuance_id": "000004C463C52827307480341125DA0577DEFC38405B0E3E",
+						"mptokens": []any{
+							map[string]any{
+								"account":       "rEiNkzogdHEzUxPfsri5XSMqtXUixf2Yx",
+								"flags":         0,
+								"mpt_amount":    "20",
+								"mptoken_index": "36D91DEE5EFE4A93119A8B84C944A528F2B444329F3846E49FE921040DE17E65",
+							},
+						},
+						"limit":        50,
+						"ledger_index": 11,
+						"validated":    true,
+					},
+				},
+			},
+			expected: &clio.MPTHoldersResponse{
+				MPTIssuanceID: "000004C463C52827307480341125DA0577DEFC38405B0E3E",
+				MPTokens: []cliotypes.MPTHolder{
+					{
+						Account:      "rEiNkzogdHEzUxPfsri5XSMqtXUixf2Yx",
+						MPTAmount:    "20",
+						MPTokenIndex: "36D91DEE5EFE4A93119A8B84C944A528F2B444329F3846E49FE921040DE17E65",
+					},
+				},
+				Limit:       50,
+				LedgerIndex: 11,
+				Validated:   true,
+			},
+		},
+		{
+			name: "fail - rippled server",
+			serverMessages: []map[string]any{
+				{"id": 1, "result": map[string]any{"info": map[string]any{"build_version": "2.3.0"}}},
+			},
+			expectedErr: ErrClioRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			res, err := cl.GetMPTHolders(&clio.MPTHoldersRequest{MPTIssuanceID: "000004C463C52827307480341125DA0577DEFC38405B0E3E"})
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
@@ -1,9 +1,17 @@
 package websocket
 
 import (
+	"crypto/tls"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
 	"time"
 
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/engine"
+	"github.com/gorilla/websocket"
 )
 
 // ClientConfig configures options for the XRPL WebSocket client.
@@ -15,6 +23,46 @@ type ClientConfig struct {
 	retryDelay    time.Duration
 	timeout       time.Duration
 
+	// Reconnection config
+	reconnectBackoff    time.Duration
+	maxReconnectBackoff time.Duration
+
+	// Keepalive config
+	pingInterval         time.Duration
+	pongTimeout          time.Duration
+	applicationHeartbeat bool
+
+	// Dial config
+	dialer           *websocket.Dialer
+	header           http.Header
+	tlsConfig        *tls.Config
+	proxy            func(*http.Request) (*url.URL, error)
+	handshakeTimeout time.Duration
+	readLimit        int64
+	compression      bool
+
+	// Request config
+	maxInFlightRequests int
+
+	// Interceptors notified of every request
+	interceptors xrpl.Interceptors
+
+	// Logger of reconnects, dropped messages, decoding failures and autofill decisions
+	logger *slog.Logger
+
+	// Rate limit config
+	rateLimit            float64
+	rateLimitBurst       int
+	loadFactorThrottling bool
+
+	// Transaction config
+	pollTransactions bool
+	sequences        *engine.SequenceManager
+
+	// Stream config
+	streamBufferSize     int
+	streamOverflowPolicy StreamOverflowPolicy
+
 	// Fee config
 	feeCushion float32
 	maxFeeXRP  float32
@@ -33,6 +81,12 @@ func NewClientConfig() *ClientConfig {
 		maxReconnects: common.DefaultMaxReconnects,
 		retryDelay:    common.DefaultRetryDelay,
 		timeout:       common.DefaultTimeout,
+
+		reconnectBackoff:    common.DefaultReconnectBackoff,
+		maxReconnectBackoff: common.DefaultMaxReconnectBackoff,
+
+		streamBufferSize:     common.DefaultStreamBufferSize,
+		streamOverflowPolicy: StreamOverflowError,
 	}
 }
 
@@ -91,3 +145,184 @@ func (wc ClientConfig) WithTimeout(timeout time.Duration) ClientConfig {
 	wc.timeout = timeout
 	return wc
 }
+
+// WithDialer sets the dialer used to open websocket connections.
+// The other dial options (headers, TLS config, proxy, handshake timeout and compression) are applied on a copy of it.
+// Default: websocket.DefaultDialer
+func (wc ClientConfig) WithDialer(dialer *websocket.Dialer) ClientConfig {
+	wc.dialer = dialer
+	return wc
+}
+
+// WithHeaders sets the HTTP headers sent with the websocket handshake, such as authorization headers.
+// Default: none
+func (wc ClientConfig) WithHeaders(header http.Header) ClientConfig {
+	wc.header = header
+	return wc
+}
+
+// WithTLSConfig sets the TLS configuration used for wss connections, for example to pin certificates.
+// Default: nil (Go's default TLS configuration)
+func (wc ClientConfig) WithTLSConfig(tlsConfig *tls.Config) ClientConfig {
+	wc.tlsConfig = tlsConfig
+	return wc
+}
+
+// WithProxy sets the function returning the proxy to use for a given handshake request.
+// Default: http.ProxyFromEnvironment
+func (wc ClientConfig) WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientConfig {
+	wc.proxy = proxy
+	return wc
+}
+
+// WithHandshakeTimeout sets the maximum duration of the websocket handshake.
+// Default: 45s
+func (wc ClientConfig) WithHandshakeTimeout(handshakeTimeout time.Duration) ClientConfig {
+	wc.handshakeTimeout = handshakeTimeout
+	return wc
+}
+
+// WithReadLimit sets the maximum size in bytes of a message read from the server.
+// Reading a larger message fails and closes the connection.
+// Default: 0 (unlimited)
+func (wc ClientConfig) WithReadLimit(readLimit int64) ClientConfig {
+	wc.readLimit = readLimit
+	return wc
+}
+
+// WithCompression enables permessage-deflate compression, if the server supports it.
+// Default: false
+func (wc ClientConfig) WithCompression(enabled bool) ClientConfig {
+	wc.compression = enabled
+	return wc
+}
+
+// WithReconnectBackoff sets the delay before the first reconnection attempt and the maximum delay between attempts.
+// The delay doubles after every failed attempt until it reaches the maximum.
+// Default: 500ms, 30s
+func (wc ClientConfig) WithReconnectBackoff(backoff, maxBackoff time.Duration) ClientConfig {
+	wc.reconnectBackoff = backoff
+	wc.maxReconnectBackoff = maxBackoff
+	return wc
+}
+
+// WithKeepAlive pings the server every pingInterval. If nothing is received from the server within
+// pingInterval plus pongTimeout, the connection is considered dead and the client reconnects.
+// Default: disabled
+func (wc ClientConfig) WithKeepAlive(pingInterval, pongTimeout time.Duration) ClientConfig {
+	wc.pingInterval = pingInterval
+	wc.pongTimeout = pongTimeout
+	return wc
+}
+
+// WithApplicationHeartbeat makes the keepalive send XRPL ping requests instead of websocket ping frames.
+// This is useful when a proxy answers websocket pings on behalf of the server.
+// Default: false
+func (wc ClientConfig) WithApplicationHeartbeat(enabled bool) ClientConfig {
+	wc.applicationHeartbeat = enabled
+	return wc
+}
+
+// WithMaxInFlightRequests sets the maximum number of requests that can be awaiting a response at the same time.
+// Further requests block until a slot is freed or their context is done.
+// Default: 0 (unlimited)
+func (wc ClientConfig) WithMaxInFlightRequests(maxInFlightRequests int) ClientConfig {
+	wc.maxInFlightRequests = maxInFlightRequests
+	return wc
+}
+
+// WithInterceptors adds interceptors notified before every request is sent and after its response or
+// error is received, for example for logging, tracing or metrics.
+// Default: none
+func (wc ClientConfig) WithInterceptors(interceptors ...xrpl.Interceptor) ClientConfig {
+	wc.interceptors = append(slices.Clone(wc.interceptors), interceptors...)
+	return wc
+}
+
+// WithLogger sets the logger receiving structured records of reconnects, dropped stream messages,
+// decoding failures, fee calculations and autofill decisions.
+// Default: none (nothing is logged)
+func (wc ClientConfig) WithLogger(logger *slog.Logger) ClientConfig {
+	wc.logger = logger
+	return wc
+}
+
+// WithRateLimit limits the client to rate requests per second, with bursts of up to burst requests.
+// Requests wait until they are allowed to be sent.
+// Default: 0 (unlimited)
+func (wc ClientConfig) WithRateLimit(rate float64, burst int) ClientConfig {
+	wc.rateLimit = rate
+	wc.rateLimitBurst = burst
+	return wc
+}
+
+// WithLoadFactorThrottling makes the rate limit adapt to the load of the server. The rate is divided by
+// the load factor of every server_info response and server stream message.
+// It has no effect without WithRateLimit.
+// Default: false
+func (wc ClientConfig) WithLoadFactorThrottling(enabled bool) ClientConfig {
+	wc.loadFactorThrottling = enabled
+	return wc
+}
+
+// WithTransactionPolling makes SubmitTxAndWait and SubmitTxBlobAndWait wait for transactions by polling the
+// tx method every retry delay, instead of subscribing to the submitting account and the ledger stream.
+// Default: false
+func (wc ClientConfig) WithTransactionPolling(enabled bool) ClientConfig {
+	wc.pollTransactions = enabled
+	return wc
+}
+
+// WithSequenceManager sets the sequence manager handing out the Sequence of autofilled transactions, instead
+// of fetching it with account_info for every transaction. The same manager can be shared by several clients.
+// Default: nil
+func (wc ClientConfig) WithSequenceManager(m *engine.SequenceManager) ClientConfig {
+	wc.sequences = m
+	return wc
+}
+
+// WithStreamBuffer sets the size of the buffer of every stream handler and what to do when it's full.
+// StreamOverflowBlock never loses a message, but a slow handler then stalls the reading of every other
+// message, including request responses, once its buffer is full.
+// Default: 256, StreamOverflowError
+func (wc ClientConfig) WithStreamBuffer(size int, policy StreamOverflowPolicy) ClientConfig {
+	wc.streamBufferSize = size
+	wc.streamOverflowPolicy = policy
+	return wc
+}
+
+// discardLogger is used when no logger is configured.
+var discardLogger = slog.New(slog.DiscardHandler)
+
+// log returns the configured logger, or a logger discarding every record if none is set.
+func (wc ClientConfig) log() *slog.Logger {
+	if wc.logger == nil {
+		return discardLogger
+	}
+	return wc.logger
+}
+
+// newDialer returns the dialer configured with the dial options, or nil to use websocket.DefaultDialer.
+func (wc ClientConfig) newDialer() *websocket.Dialer {
+	if wc.dialer == nil && wc.tlsConfig == nil && wc.proxy == nil && wc.handshakeTimeout == 0 && !wc.compression {
+		return nil
+	}
+
+	dialer := *websocket.DefaultDialer
+	if wc.dialer != nil {
+		dialer = *wc.dialer
+	}
+	if wc.tlsConfig != nil {
+		dialer.TLSClientConfig = wc.tlsConfig
+	}
+	if wc.proxy != nil {
+		dialer.Proxy = wc.proxy
+	}
+	if wc.handshakeTimeout > 0 {
+		dialer.HandshakeTimeout = wc.handshakeTimeout
+	}
+	if wc.compression {
+		dialer.EnableCompression = true
+	}
+	return &dialer
+}
@@ -1,11 +1,16 @@
 package websocket
 
 import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
 	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/engine"
 	"github.com/Peersyst/xrpl-go/xrpl/faucet"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,6 +22,10 @@ func TestNewClientConfig(t *testing.T) {
 	require.Equal(t, config.feeCushion, common.DefaultFeeCushion)
 	require.Equal(t, config.maxFeeXRP, common.DefaultMaxFeeXRP)
 	require.Equal(t, config.timeout, common.DefaultTimeout)
+	require.Equal(t, config.reconnectBackoff, common.DefaultReconnectBackoff)
+	require.Equal(t, config.maxReconnectBackoff, common.DefaultMaxReconnectBackoff)
+	require.Equal(t, config.streamBufferSize, common.DefaultStreamBufferSize)
+	require.Equal(t, config.streamOverflowPolicy, StreamOverflowError)
 }
 
 func TestWithMaxRetries(t *testing.T) {
@@ -48,3 +57,87 @@ func TestWithTimeout(t *testing.T) {
 	config := NewClientConfig().WithTimeout(10 * time.Second)
 	require.Equal(t, config.timeout, 10*time.Second)
 }
+
+func TestWithMaxInFlightRequests(t *testing.T) {
+	config := NewClientConfig().WithMaxInFlightRequests(8)
+	require.Equal(t, config.maxInFlightRequests, 8)
+}
+
+func TestWithReconnectBackoff(t *testing.T) {
+	config := NewClientConfig().WithReconnectBackoff(time.Second, time.Minute)
+	require.Equal(t, config.reconnectBackoff, time.Second)
+	require.Equal(t, config.maxReconnectBackoff, time.Minute)
+}
+
+func TestWithRateLimit(t *testing.T) {
+	config := NewClientConfig().WithRateLimit(10, 5).WithLoadFactorThrottling(true)
+	require.Equal(t, config.rateLimit, float64(10))
+	require.Equal(t, config.rateLimitBurst, 5)
+	require.True(t, config.loadFactorThrottling)
+}
+
+func TestWithSequenceManager(t *testing.T) {
+	m := engine.NewSequenceManager()
+	config := NewClientConfig().WithSequenceManager(m)
+	require.Same(t, m, config.sequences)
+}
+
+func TestWithStreamBuffer(t *testing.T) {
+	config := NewClientConfig().WithStreamBuffer(16, StreamOverflowDropOldest)
+	require.Equal(t, config.streamBufferSize, 16)
+	require.Equal(t, config.streamOverflowPolicy, StreamOverflowDropOldest)
+}
+
+func TestWithKeepAlive(t *testing.T) {
+	config := NewClientConfig().WithKeepAlive(30*time.Second, 10*time.Second)
+	require.Equal(t, config.pingInterval, 30*time.Second)
+	require.Equal(t, config.pongTimeout, 10*time.Second)
+}
+
+func TestWithApplicationHeartbeat(t *testing.T) {
+	config := NewClientConfig().WithApplicationHeartbeat(true)
+	require.True(t, config.applicationHeartbeat)
+}
+
+func TestWithDialOptions(t *testing.T) {
+	header := http.Header{"Authorization": []string{"Bearer token"}}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS13}
+	proxyURL, _ := url.Parse("http://proxy.example.com:8080")
+
+	config := NewClientConfig().
+		WithHeaders(header).
+		WithTLSConfig(tlsConfig).
+		WithProxy(http.ProxyURL(proxyURL)).
+		WithHandshakeTimeout(5 * time.Second).
+		WithReadLimit(1 << 20).
+		WithCompression(true)
+
+	require.Equal(t, header, config.header)
+	require.Equal(t, int64(1<<20), config.readLimit)
+
+	dialer := config.newDialer()
+	require.NotNil(t, dialer)
+	require.Same(t, tlsConfig, dialer.TLSClientConfig)
+	require.Equal(t, 5*time.Second, dialer.HandshakeTimeout)
+	require.True(t, dialer.EnableCompression)
+	proxy, err := dialer.Proxy(nil)
+	require.NoError(t, err)
+	require.Equal(t, proxyURL, proxy)
+}
+
+func TestNewDialer(t *testing.T) {
+	t.Run("no dial options uses the default dialer", func(t *testing.T) {
+		require.Nil(t, NewClientConfig().newDialer())
+	})
+
+	t.Run("custom dialer is copied before applying options", func(t *testing.T) {
+		base := &websocket.Dialer{HandshakeTimeout: time.Second, ReadBufferSize: 2048}
+		dialer := NewClientConfig().WithDialer(base).WithCompression(true).newDialer()
+
+		require.NotSame(t, base, dialer)
+		require.Equal(t, 2048, dialer.ReadBufferSize)
+		require.Equal(t, time.Second, dialer.HandshakeTimeout)
+		require.True(t, dialer.EnableCompression)
+		require.False(t, base.EnableCompression)
+	})
+}
@@ -1,19 +1,36 @@
 package websocket
 
 import (
+	"errors"
+	"net"
+	"net/http"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
 
 // Connection is a wrapper around a websocket connection.
 // It provides a method to read messages from the connection.
-// All methods are safe for concurrent use.
+// All methods are safe for concurrent use, except ReadMessage, which must only be called by a single
+// goroutine at a time.
 type Connection struct {
 	conn *websocket.Conn
 	url  string
 
+	// Dial options. A nil dialer uses websocket.DefaultDialer.
+	dialer      *websocket.Dialer
+	header      http.Header
+	readLimit   int64
+	compression bool
+
+	// readTimeout is how long the connection can stay silent before reads fail. Every message and pong
+	// received extends it. Zero disables it.
+	readTimeout time.Duration
+
 	mu sync.Mutex
+	// writeMu serializes writes, as the underlying connection supports a single concurrent writer.
+	writeMu sync.Mutex
 }
 
 // NewConnection creates a new Connection.
@@ -28,10 +45,30 @@ func (c *Connection) Connect() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	conn, _, err := websocket.DefaultDialer.Dial(c.url, nil)
+	dialer := c.dialer
+	if dialer == nil {
+		dialer = websocket.DefaultDialer
+	}
+	conn, _, err := dialer.Dial(c.url, c.header)
 	if err != nil {
 		return err
 	}
+	if c.readLimit > 0 {
+		conn.SetReadLimit(c.readLimit)
+	}
+	conn.EnableWriteCompression(c.compression)
+	if c.conn != nil {
+		// Release the previous connection when reconnecting.
+		_ = c.conn.Close()
+	}
+	if c.readTimeout > 0 {
+		if err := conn.SetReadDeadline(time.Now().Add(c.readTimeout)); err != nil {
+			return err
+		}
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(c.readTimeout))
+		})
+	}
 	c.conn = conn
 	return nil
 }
@@ -42,7 +79,7 @@ func (c *Connection) Disconnect() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if !c.IsConnected() {
+	if c.conn == nil {
 		return ErrNotConnected
 	}
 
@@ -55,28 +92,63 @@ func (c *Connection) Disconnect() error {
 
 // IsConnected returns true if the connection is connected.
 func (c *Connection) IsConnected() bool {
-	return c.conn != nil
+	return c.current() != nil
+}
+
+// current returns the underlying connection, or nil if it's not connected. Methods use the returned
+// connection rather than c.conn, which Connect and Disconnect may replace concurrently.
+func (c *Connection) current() *websocket.Conn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.conn
 }
 
 // ReadMessage reads a message from the connection.
 // It returns the message and an error if the message is not read.
 // This method is blocking, it will block until a message is read.
 func (c *Connection) ReadMessage() ([]byte, error) {
-	if !c.IsConnected() {
+	conn := c.current()
+	if conn == nil {
 		return nil, ErrNotConnected
 	}
-	_, message, err := c.conn.ReadMessage()
+	_, message, err := conn.ReadMessage()
 	if err != nil {
 		return nil, err
 	}
+	if c.readTimeout > 0 {
+		if err := conn.SetReadDeadline(time.Now().Add(c.readTimeout)); err != nil {
+			return nil, err
+		}
+	}
 	return message, nil
 }
 
 // WriteMessage writes a message to the connection.
 // It returns an error if the message is not written.
 func (c *Connection) WriteMessage(message []byte) error {
-	if !c.IsConnected() {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	conn := c.current()
+	if conn == nil {
 		return ErrNotConnected
 	}
-	return c.conn.WriteMessage(websocket.TextMessage, message)
+	return conn.WriteMessage(websocket.TextMessage, message)
+}
+
+// Ping writes a websocket ping control frame to the connection.
+// The write fails if it cannot be completed before deadline.
+func (c *Connection) Ping(deadline time.Time) error {
+	conn := c.current()
+	if conn == nil {
+		return ErrNotConnected
+	}
+	return conn.WriteControl(websocket.PingMessage, nil, deadline)
+}
+
+// isReadTimeout reports whether err was caused by the connection staying silent longer than its read timeout.
+func isReadTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
 }
@@ -0,0 +1,89 @@
+package websocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnection_Connect(t *testing.T) {
+	t.Run("sends the configured handshake headers", func(t *testing.T) {
+		received := make(chan http.Header, 1)
+		upgrader := websocket.Upgrader{}
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received <- r.Header
+			c, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer c.Close()
+		}))
+		defer s.Close()
+
+		url, _ := testutil.ConvertHTTPToWS(s.URL)
+		conn := NewConnection(url)
+		conn.header = http.Header{"Authorization": []string{"Bearer token"}}
+
+		require.NoError(t, conn.Connect())
+		defer conn.Disconnect()
+
+		require.Equal(t, "Bearer token", (<-received).Get("Authorization"))
+	})
+
+	t.Run("fails to read messages larger than the read limit", func(t *testing.T) {
+		ws := &testutil.MockWebSocketServer{}
+		s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+			_ = c.WriteMessage(websocket.TextMessage, make([]byte, 1024))
+		})
+		defer s.Close()
+
+		url, _ := testutil.ConvertHTTPToWS(s.URL)
+		conn := NewConnection(url)
+		conn.readLimit = 512
+
+		require.NoError(t, conn.Connect())
+		defer conn.Disconnect()
+
+		_, err := conn.ReadMessage()
+		require.ErrorIs(t, err, websocket.ErrReadLimit)
+	})
+}
+
+func TestConnection_Disconnect(t *testing.T) {
+	ws := &testutil.MockWebSocketServer{}
+	s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	defer s.Close()
+
+	url, _ := testutil.ConvertHTTPToWS(s.URL)
+	conn := NewConnection(url)
+	require.NoError(t, conn.Connect())
+
+	// Pings racing with Disconnect either succeed or fail cleanly.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 100 {
+			_ = conn.Ping(time.Now().Add(time.Second))
+		}
+	}()
+	require.NoError(t, conn.Disconnect())
+	<-done
+
+	require.False(t, conn.IsConnected())
+	require.ErrorIs(t, conn.Ping(time.Now().Add(time.Second)), ErrNotConnected)
+	require.ErrorIs(t, conn.WriteMessage([]byte("{}")), ErrNotConnected)
+	_, err := conn.ReadMessage()
+	require.ErrorIs(t, err, ErrNotConnected)
+	require.ErrorIs(t, conn.Disconnect(), ErrNotConnected)
+}
@@ -3,51 +3,51 @@ package websocket
 import (
 	"errors"
 	"fmt"
-)
 
-const (
-	// txnNotFound is the error message returned by the xrpl node when requesting for a not found transaction.
-	txnNotFound = "txnNotFound"
+	"github.com/Peersyst/xrpl-go/xrpl/engine"
 )
 
 var (
 	// transaction
 
 	// ErrMissingTxSignatureOrSigningPubKey is returned when a transaction lacks both TxSignature and SigningPubKey.
-	ErrMissingTxSignatureOrSigningPubKey = errors.New("transaction must include either TxSignature or SigningPubKey")
+	ErrMissingTxSignatureOrSigningPubKey = engine.ErrMissingTxSignatureOrSigningPubKey
 	// ErrMissingLastLedgerSequenceInTransaction is returned when LastLedgerSequence is missing from a transaction.
-	ErrMissingLastLedgerSequenceInTransaction = errors.New("missing LastLedgerSequence in transaction")
+	ErrMissingLastLedgerSequenceInTransaction = engine.ErrMissingLastLedgerSequenceInTransaction
+	// ErrIncompleteLedgerHistory is returned when the outcome of a transaction can't be determined because the
+	// server lacks some of the ledgers that could include it.
+	ErrIncompleteLedgerHistory = engine.ErrIncompleteLedgerHistory
 	// ErrMissingWallet is returned when a wallet is required but not provided for an unsigned transaction.
-	ErrMissingWallet = errors.New("wallet must be provided when submitting an unsigned transaction")
+	ErrMissingWallet = engine.ErrMissingWallet
 	// ErrTransactionNotFound is returned when a transaction cannot be found.
-	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrTransactionNotFound = engine.ErrTransactionNotFound
 	// ErrMissingAccountInTransaction is returned when the Account field is missing from a transaction.
-	ErrMissingAccountInTransaction = errors.New("missing Account in transaction")
+	ErrMissingAccountInTransaction = engine.ErrMissingAccountInTransaction
 	// ErrTransactionTypeMissing is returned when the transaction type is missing from a transaction.
-	ErrTransactionTypeMissing = errors.New("transaction type is missing in transaction")
+	ErrTransactionTypeMissing = engine.ErrTransactionTypeMissing
 	// ErrInvalidFulfillmentLength is returned when the fulfillment length is invalid.
-	ErrInvalidFulfillmentLength = errors.New("invalid fulfillment length")
+	ErrInvalidFulfillmentLength = engine.ErrInvalidFulfillmentLength
 
 	// fields
 
 	// ErrRawTransactionsFieldIsNotAnArray is returned when the RawTransactions field is not an array type.
-	ErrRawTransactionsFieldIsNotAnArray = errors.New("field RawTransactions must be an array")
+	ErrRawTransactionsFieldIsNotAnArray = engine.ErrRawTransactionsFieldIsNotAnArray
 	// ErrRawTransactionFieldIsNotAnObject is returned when the RawTransaction field is not an object type.
-	ErrRawTransactionFieldIsNotAnObject = errors.New("field RawTransaction must be an object")
+	ErrRawTransactionFieldIsNotAnObject = engine.ErrRawTransactionFieldIsNotAnObject
 	// ErrSigningPubKeyFieldMustBeEmpty is returned when the SigningPubKey field should be empty but isn't.
-	ErrSigningPubKeyFieldMustBeEmpty = errors.New("field SigningPubKey must be empty")
+	ErrSigningPubKeyFieldMustBeEmpty = engine.ErrSigningPubKeyFieldMustBeEmpty
 	// ErrTxnSignatureFieldMustBeEmpty is returned when the TxnSignature field should be empty but isn't.
-	ErrTxnSignatureFieldMustBeEmpty = errors.New("field TxnSignature must be empty")
+	ErrTxnSignatureFieldMustBeEmpty = engine.ErrTxnSignatureFieldMustBeEmpty
 	// ErrSignersFieldMustBeEmpty is returned when the Signers field should be empty but isn't.
-	ErrSignersFieldMustBeEmpty = errors.New("field Signers must be empty")
+	ErrSignersFieldMustBeEmpty = engine.ErrSignersFieldMustBeEmpty
 	// ErrAccountFieldIsNotAString is returned when the Account field is not a string type.
-	ErrAccountFieldIsNotAString = errors.New("field Account must be a string")
+	ErrAccountFieldIsNotAString = engine.ErrAccountFieldIsNotAString
 	// ErrRawTransactionsFieldMissing is returned when the RawTransactions field is missing from a Batch transaction.
-	ErrRawTransactionsFieldMissing = errors.New("RawTransactions field missing from Batch transaction")
+	ErrRawTransactionsFieldMissing = engine.ErrRawTransactionsFieldMissing
 	// ErrRawTransactionFieldMissing is returned when the RawTransaction field is missing from a wrapper.
-	ErrRawTransactionFieldMissing = errors.New("RawTransaction field missing from wrapper")
+	ErrRawTransactionFieldMissing = engine.ErrRawTransactionFieldMissing
 	// ErrFeeFieldMissing is returned when the fee field is missing after calculation.
-	ErrFeeFieldMissing = errors.New("fee field missing after calculation")
+	ErrFeeFieldMissing = engine.ErrFeeFieldMissing
 
 	// client
 
@@ -57,8 +57,10 @@ var (
 	ErrNotConnectedToServer = errors.New("not connected to server")
 	// ErrRequestTimedOut indicates that a request to the server timed out.
 	ErrRequestTimedOut = errors.New("request timed out")
+	// ErrConnectionLost indicates that the connection was closed before a response was received.
+	ErrConnectionLost = errors.New("connection lost before receiving a response")
 	// ErrSignerDataIsEmpty is returned when signer data is empty or missing.
-	ErrSignerDataIsEmpty = errors.New("signer data is empty")
+	ErrSignerDataIsEmpty = engine.ErrSignerDataIsEmpty
 
 	// wallet
 
@@ -68,27 +70,35 @@ var (
 	// fees
 
 	// ErrCouldNotGetBaseFeeXrp is returned when BaseFeeXrp cannot be retrieved from ServerInfo.
-	ErrCouldNotGetBaseFeeXrp = errors.New("get fee xrp: could not get BaseFeeXrp from ServerInfo")
+	ErrCouldNotGetBaseFeeXrp = engine.ErrCouldNotGetBaseFeeXrp
 	// ErrCouldNotFetchOwnerReserve is returned when the owner reserve fee cannot be fetched.
-	ErrCouldNotFetchOwnerReserve = errors.New("could not fetch Owner Reserve")
+	ErrCouldNotFetchOwnerReserve = engine.ErrCouldNotFetchOwnerReserve
 	// ErrLoanBrokerIDRequired is returned when LoanBrokerID is required but not provided.
-	ErrLoanBrokerIDRequired = errors.New("LoanBrokerID is required for LoanSet transaction")
+	ErrLoanBrokerIDRequired = engine.ErrLoanBrokerIDRequired
 	// ErrCouldNotFetchLoanBroker is returned when the LoanBroker cannot be fetched.
 	ErrCouldNotFetchLoanBroker = errors.New("could not fetch LoanBroker")
 	// ErrCouldNotFetchLoanBrokerOwner is returned when the Owner field cannot be extracted from LoanBroker.
-	ErrCouldNotFetchLoanBrokerOwner = errors.New("could not fetch LoanBroker Owner")
+	ErrCouldNotFetchLoanBrokerOwner = engine.ErrCouldNotFetchLoanBrokerOwner
 	// ErrCounterpartyRequired is returned when Counterparty is required but not provided.
-	ErrCounterpartyRequired = errors.New("field Counterparty is required")
+	ErrCounterpartyRequired = engine.ErrCounterpartyRequired
 
 	// account
 
 	// ErrAccountCannotBeDeleted is returned when an account cannot be deleted due to associated objects.
-	ErrAccountCannotBeDeleted = errors.New("account cannot be deleted; there are Escrows, PayChannels, RippleStates, or Checks associated with the account")
+	ErrAccountCannotBeDeleted = engine.ErrAccountCannotBeDeleted
 
 	// payment
 
 	// ErrAmountAndDeliverMaxMustBeIdentical is returned when Amount and DeliverMax fields are not identical.
-	ErrAmountAndDeliverMaxMustBeIdentical = errors.New("payment transaction: Amount and DeliverMax fields must be identical when both are provided")
+	ErrAmountAndDeliverMaxMustBeIdentical = engine.ErrAmountAndDeliverMaxMustBeIdentical
+
+	// server
+
+	// ErrClioRequired is returned when a Clio-only method is called on a server that isn't a Clio server.
+	ErrClioRequired = engine.ErrClioRequired
+	// ErrRepeatedMarker is returned when iterating over a paged query and the server returns the same marker
+	// for two pages in a row.
+	ErrRepeatedMarker = engine.ErrRepeatedMarker
 
 	// connection
 
@@ -108,6 +118,16 @@ func (e *ClientError) Error() string {
 	return e.ErrorString
 }
 
+// ErrStreamBufferFull is reported when a stream message is dropped because the buffer of its handler is full.
+type ErrStreamBufferFull struct {
+	Stream string
+}
+
+// Error implements the error interface for ErrStreamBufferFull
+func (e ErrStreamBufferFull) Error() string {
+	return fmt.Sprintf("stream buffer full, dropped %s message", e.Stream)
+}
+
 // ErrUnknownStreamType is returned when an unknown stream type is encountered.
 type ErrUnknownStreamType struct {
 	Type interface{}
@@ -129,12 +149,10 @@ func (e ErrMaxReconnectionAttemptsReached) Error() string {
 }
 
 // ErrFailedToParseFee is returned when fee parsing fails.
-type ErrFailedToParseFee struct {
-	Fee string
-	Err error
-}
+type ErrFailedToParseFee = engine.ErrFailedToParseFee
 
-// Error implements the error interface for ErrFailedToParseFee
-func (e ErrFailedToParseFee) Error() string {
-	return fmt.Sprintf("failed to parse fee %s: %v", e.Fee, e.Err)
-}
+// ErrUnexpectedLedgerEntryType is returned when the ledger entry returned by ledger_entry isn't of the requested type.
+type ErrUnexpectedLedgerEntryType = engine.ErrUnexpectedLedgerEntryType
+
+// ErrMismatchedTag is returned when a transaction tag field does not match the expected value.
+type ErrMismatchedTag = engine.ErrMismatchedTag
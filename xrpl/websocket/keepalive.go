@@ -0,0 +1,34 @@
+package websocket
+
+import (
+	"context"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+)
+
+// keepAlive pings the server every ping interval until stop is closed.
+// Pings don't report failures: a connection that doesn't answer stays silent past its read timeout,
+// and the reading goroutine reconnects it.
+func (c *Client) keepAlive(stop <-chan struct{}) {
+	ticker := time.NewTicker(c.cfg.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		if c.cfg.applicationHeartbeat {
+			go func() {
+				ctx, cancel := context.WithTimeout(context.Background(), c.cfg.pongTimeout)
+				defer cancel()
+				_, _ = c.PingContext(ctx, &utility.PingRequest{})
+			}()
+			continue
+		}
+		_ = c.conn.Ping(time.Now().Add(c.cfg.pongTimeout))
+	}
+}
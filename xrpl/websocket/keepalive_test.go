@@ -0,0 +1,111 @@
+package websocket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	wstypes "github.com/Peersyst/xrpl-go/xrpl/websocket/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_KeepAlive(t *testing.T) {
+	t.Run("reconnects when the server stops answering pings", func(t *testing.T) {
+		connections := make(chan *websocket.Conn, 10)
+		ws := &testutil.MockWebSocketServer{}
+		s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+			// Pongs are only sent while reading, so never reading leaves pings unanswered.
+			connections <- c
+		})
+		defer s.Close()
+
+		url, _ := testutil.ConvertHTTPToWS(s.URL)
+		cl := NewClient(NewClientConfig().
+			WithHost(url).
+			WithKeepAlive(20*time.Millisecond, 20*time.Millisecond).
+			WithReconnectBackoff(time.Millisecond, time.Millisecond))
+
+		reconnected := make(chan *wstypes.ReconnectEvent, 1)
+		cl.OnReconnect(func(event *wstypes.ReconnectEvent) {
+			reconnected <- event
+		})
+
+		require.NoError(t, cl.Connect())
+		defer cl.Disconnect()
+
+		select {
+		case event := <-reconnected:
+			require.Equal(t, 1, event.Attempts)
+		case <-time.After(2 * time.Second):
+			t.Fatal("reconnect event not received")
+		}
+		require.GreaterOrEqual(t, len(connections), 2)
+	})
+
+	t.Run("keeps the connection alive while the server answers pings", func(t *testing.T) {
+		ws := &testutil.MockWebSocketServer{}
+		s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+			for {
+				if _, _, err := c.ReadMessage(); err != nil {
+					return
+				}
+			}
+		})
+		defer s.Close()
+
+		url, _ := testutil.ConvertHTTPToWS(s.URL)
+		cl := NewClient(NewClientConfig().
+			WithHost(url).
+			WithKeepAlive(10*time.Millisecond, 20*time.Millisecond))
+
+		reconnected := make(chan *wstypes.ReconnectEvent, 1)
+		cl.OnReconnect(func(event *wstypes.ReconnectEvent) {
+			reconnected <- event
+		})
+
+		require.NoError(t, cl.Connect())
+		defer cl.Disconnect()
+
+		select {
+		case <-reconnected:
+			t.Fatal("unexpected reconnection")
+		case <-time.After(200 * time.Millisecond):
+		}
+		require.True(t, cl.IsConnected())
+	})
+
+	t.Run("application heartbeat sends ping requests", func(t *testing.T) {
+		commands := make(chan any, 10)
+		ws := &testutil.MockWebSocketServer{}
+		s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+			for {
+				var req map[string]any
+				if err := c.ReadJSON(&req); err != nil {
+					return
+				}
+				commands <- req["command"]
+				if err := c.WriteJSON(map[string]any{"id": req["id"], "result": map[string]any{}}); err != nil {
+					return
+				}
+			}
+		})
+		defer s.Close()
+
+		url, _ := testutil.ConvertHTTPToWS(s.URL)
+		cl := NewClient(NewClientConfig().
+			WithHost(url).
+			WithKeepAlive(10*time.Millisecond, 50*time.Millisecond).
+			WithApplicationHeartbeat(true))
+
+		require.NoError(t, cl.Connect())
+		defer cl.Disconnect()
+
+		select {
+		case command := <-commands:
+			require.Equal(t, "ping", command)
+		case <-time.After(time.Second):
+			t.Fatal("ping request not received")
+		}
+	})
+}
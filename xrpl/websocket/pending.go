@@ -0,0 +1,64 @@
+package websocket
+
+import "sync"
+
+// pendingRequests tracks the requests that are waiting for a response, keyed by request ID.
+// Every request gets its own buffered channel, so a response is never delivered to the wrong caller.
+// The zero value is ready to use and all methods are safe for concurrent use.
+type pendingRequests struct {
+	mu       sync.Mutex
+	requests map[int]chan *pendingResponse
+}
+
+// pendingResponse is a response delivered to a pending request, along with the raw message it was read from.
+type pendingResponse struct {
+	res *ClientResponse
+	raw []byte
+}
+
+// add registers a request ID and returns the channel its response will be delivered to.
+func (p *pendingRequests) add(id int) chan *pendingResponse {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.requests == nil {
+		p.requests = make(map[int]chan *pendingResponse)
+	}
+	ch := make(chan *pendingResponse, 1)
+	p.requests[id] = ch
+	return ch
+}
+
+// remove unregisters a request ID. It is a no-op if the ID is not registered.
+func (p *pendingRequests) remove(id int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.requests, id)
+}
+
+// resolve delivers a response, read from the raw message, to the request with the same ID.
+// It returns false if no request with that ID is waiting.
+func (p *pendingRequests) resolve(res *ClientResponse, raw []byte) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ch, ok := p.requests[res.ID]
+	if !ok {
+		return false
+	}
+	delete(p.requests, res.ID)
+	ch <- &pendingResponse{res: res, raw: raw}
+	return true
+}
+
+// failAll closes the channels of every waiting request, signalling that no response will arrive.
+func (p *pendingRequests) failAll() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for id, ch := range p.requests {
+		close(ch)
+		delete(p.requests, id)
+	}
+}
@@ -0,0 +1,53 @@
+package websocket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingRequests(t *testing.T) {
+	t.Run("resolve delivers the response to the matching request", func(t *testing.T) {
+		var p pendingRequests
+		ch1 := p.add(1)
+		ch2 := p.add(2)
+
+		require.True(t, p.resolve(&ClientResponse{ID: 2}, []byte(`{"id":2}`)))
+		res := <-ch2
+		require.Equal(t, 2, res.res.ID)
+		require.Equal(t, []byte(`{"id":2}`), res.raw)
+		require.Len(t, ch1, 0)
+		require.Equal(t, 1, p.len())
+	})
+
+	t.Run("resolve ignores unknown request IDs", func(t *testing.T) {
+		var p pendingRequests
+		p.add(1)
+		p.remove(1)
+
+		require.False(t, p.resolve(&ClientResponse{ID: 1}, nil))
+		require.Equal(t, 0, p.len())
+	})
+
+	t.Run("failAll closes every pending channel", func(t *testing.T) {
+		var p pendingRequests
+		ch1 := p.add(1)
+		ch2 := p.add(2)
+
+		p.failAll()
+
+		_, ok := <-ch1
+		require.False(t, ok)
+		_, ok = <-ch2
+		require.False(t, ok)
+		require.Equal(t, 0, p.len())
+	})
+}
+
+// len returns the number of requests waiting for a response.
+func (p *pendingRequests) len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.requests)
+}
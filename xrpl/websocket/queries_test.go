@@ -21,6 +21,7 @@ import (
 	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	servertypes "github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -31,7 +32,11 @@ import (
 func setupTestClient(t *testing.T, messages []map[string]any) (*Client, func()) {
 	ws := &testutil.MockWebSocketServer{Msgs: messages}
 	s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+		// Answer each request with the next message, so responses never arrive before the request is sent.
 		for _, m := range messages {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
 			err := c.WriteJSON(m)
 			if err != nil {
 				t.Errorf("error writing message: %v", err)
@@ -688,6 +693,63 @@ func TestClient_GetGatewayBalances(t *testing.T) {
 	}
 }
 
+func TestClient_GetNoRippleCheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		request        *account.NoRippleCheckRequest
+		expected       *account.NoRippleCheckResponse
+		expectedErr    error
+	}{
+		{
+			name: "Valid no ripple check response",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"ledger_current_index": 14342939,
+						"problems":             []any{"You should clear the no ripple flag on your USD line to rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+						"validated":            false,
+					},
+				},
+			},
+			request: &account.NoRippleCheckRequest{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", Role: "user"},
+			expected: &account.NoRippleCheckResponse{
+				LedgerCurrentIndex: 14342939,
+				Problems:           []string{"You should clear the no ripple flag on your USD line to rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+			},
+		},
+		{
+			name:        "invalid request",
+			request:     &account.NoRippleCheckRequest{Role: "user"},
+			expectedErr: account.ErrNoAccountID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			result, err := cl.GetNoRippleCheck(tt.request)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, result) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestClient_GetLedgerIndex(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1827,6 +1889,76 @@ func TestClient_GetDepositAuthorized(t *testing.T) {
 	}
 }
 
+func TestClient_GetAMMInfo(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		request        *path.AMMInfoRequest
+		expected       *path.AMMInfoResponse
+		expectedErr    error
+	}{
+		{
+			name: "Valid AMM info response",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"amm": map[string]any{
+							"account":     "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+							"amount":      "296890496",
+							"amount2":     map[string]any{"currency": "TST", "issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", "value": "25.81656470648473"},
+							"lp_token":    map[string]any{"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", "issuer": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM", "value": "87533.41976112682"},
+							"trading_fee": 600,
+						},
+						"ledger_index": 316725,
+						"validated":    true,
+					},
+				},
+			},
+			request: &path.AMMInfoRequest{AMMAccount: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM"},
+			expected: &path.AMMInfoResponse{
+				AMM: pathtypes.AMMPool{
+					Account:    "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+					Amount:     "296890496",
+					Amount2:    map[string]any{"currency": "TST", "issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", "value": "25.81656470648473"},
+					LPToken:    types.IssuedCurrencyAmount{Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2", Issuer: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM", Value: "87533.41976112682"},
+					TradingFee: 600,
+				},
+				LedgerIndex: 316725,
+				Validated:   true,
+			},
+		},
+		{
+			name:        "invalid request",
+			request:     &path.AMMInfoRequest{},
+			expectedErr: pathtypes.ErrNoAMMSelector,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			result, err := cl.GetAMMInfo(tt.request)
+
+			if tt.expectedErr != nil {
+				if err == nil || err.Error() != tt.expectedErr.Error() {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+
+			if !reflect.DeepEqual(tt.expected, result) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestClient_FindPathCreate(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -2162,6 +2294,80 @@ func TestClient_GetRipplePathFind(t *testing.T) {
 	}
 }
 
+func TestClient_GetTransaction(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		request        *transactions.TxRequest
+		expected       *transactions.TxResponse
+		expectedErr    error
+	}{
+		{
+			name: "Valid transaction response",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"ctid":         "C005523E00000000",
+						"date":         797010521,
+						"hash":         "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+						"ledger_index": 348734,
+						"meta": map[string]any{
+							"AffectedNodes":     []any{},
+							"TransactionIndex":  2,
+							"TransactionResult": "tesSUCCESS",
+						},
+						"tx_json":   map[string]any{"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "TransactionType": "AccountSet"},
+						"validated": true,
+					},
+				},
+			},
+			request: &transactions.TxRequest{Transaction: "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2"},
+			expected: &transactions.TxResponse{
+				Date:        797010521,
+				CTID:        "C005523E00000000",
+				Hash:        "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB00F2",
+				LedgerIndex: 348734,
+				Meta: transaction.TxMetadataBuilder{
+					AffectedNodes:     []transaction.AffectedNode{},
+					TransactionIndex:  2,
+					TransactionResult: "tesSUCCESS",
+				},
+				Validated: true,
+				TxJSON:    transaction.FlatTransaction{"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "TransactionType": "AccountSet"},
+			},
+		},
+		{
+			name:        "invalid request",
+			request:     &transactions.TxRequest{CTID: "C005523E00000000", MinLedger: 348000},
+			expectedErr: transactions.ErrIncompleteLedgerRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			result, err := cl.GetTransaction(tt.request)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, result) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestClient_GetAllFeatures(t *testing.T) {
 	tests := []struct {
 		name           string
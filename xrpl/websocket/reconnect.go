@@ -0,0 +1,66 @@
+package websocket
+
+import (
+	"context"
+	"time"
+
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	wstypes "github.com/Peersyst/xrpl-go/xrpl/websocket/types"
+)
+
+// reconnect tries to open a new connection after the previous one was closed, waiting an exponentially
+// growing delay between attempts. It returns the number of attempts made and whether it succeeded.
+func (c *Client) reconnect() (int, bool) {
+	for attempt := 1; attempt <= c.cfg.maxReconnects; attempt++ {
+		time.Sleep(c.reconnectDelay(attempt))
+		if c.closed.Load() {
+			return attempt, false
+		}
+		err := c.conn.Connect()
+		if err == nil {
+			c.cfg.log().Info("reconnected", "host", c.cfg.host, "attempts", attempt)
+			return attempt, true
+		}
+		c.cfg.log().Warn("reconnection attempt failed", "host", c.cfg.host, "attempt", attempt, "error", err)
+	}
+	return c.cfg.maxReconnects, false
+}
+
+// reconnectDelay returns the delay to wait before the given reconnection attempt.
+// The delay doubles with every attempt, starting at the configured backoff and capped at the max backoff.
+func (c *Client) reconnectDelay(attempt int) time.Duration {
+	delay := c.cfg.reconnectBackoff
+	for i := 1; i < attempt && delay < c.cfg.maxReconnectBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, c.cfg.maxReconnectBackoff)
+}
+
+// resubscribe replays the active subscriptions on the new connection and emits a reconnect event.
+// It must not be called from the goroutine reading messages, as it waits for the subscribe response.
+func (c *Client) resubscribe(attempts int) {
+	event := &wstypes.ReconnectEvent{Attempts: attempts}
+	req := c.subscriptions.request()
+	if accounts, ledger := c.txWaiters.subscriptions(); len(accounts) > 0 || ledger {
+		if req == nil {
+			req = &subscribe.Request{}
+		}
+		req.Accounts = appendMissing(req.Accounts, accounts...)
+		if ledger {
+			req.Streams = appendMissing(req.Streams, ledgerStream)
+		}
+	}
+	if req != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), c.cfg.timeout)
+		defer cancel()
+		if _, err := c.RequestContext(ctx, req); err != nil {
+			c.cfg.log().Error("failed to replay subscriptions after reconnect", "error", err)
+			event.ResubscribeErr = err
+		}
+	}
+	// Transaction validations may have been missed while disconnected.
+	c.txWaiters.reconnect()
+	if c.reconnectChan != nil {
+		c.reconnectChan <- event
+	}
+}
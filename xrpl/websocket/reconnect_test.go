@@ -0,0 +1,86 @@
+package websocket
+
+import (
+	"testing"
+	"time"
+
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	wstypes "github.com/Peersyst/xrpl-go/xrpl/websocket/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_reconnectDelay(t *testing.T) {
+	cl := NewClient(NewClientConfig().WithReconnectBackoff(100*time.Millisecond, time.Second))
+
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{attempt: 1, expected: 100 * time.Millisecond},
+		{attempt: 2, expected: 200 * time.Millisecond},
+		{attempt: 3, expected: 400 * time.Millisecond},
+		{attempt: 4, expected: 800 * time.Millisecond},
+		{attempt: 5, expected: time.Second},
+		{attempt: 50, expected: time.Second},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, cl.reconnectDelay(tt.attempt))
+	}
+}
+
+func TestClient_ResubscribeAfterReconnect(t *testing.T) {
+	received := make(chan map[string]any, 2)
+	connections := 0
+
+	ws := &testutil.MockWebSocketServer{}
+	s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+		connections++
+		var req map[string]any
+		if err := c.ReadJSON(&req); err != nil {
+			return
+		}
+		received <- req
+		if err := c.WriteJSON(map[string]any{"id": req["id"], "result": map[string]any{}}); err != nil {
+			t.Errorf("error writing message: %v", err)
+		}
+		if connections == 1 {
+			// Drop the first connection so the client has to reconnect.
+			_ = c.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+			_ = c.Close()
+		}
+	})
+	defer s.Close()
+
+	url, _ := testutil.ConvertHTTPToWS(s.URL)
+	cl := NewClient(NewClientConfig().
+		WithHost(url).
+		WithTimeout(time.Second).
+		WithReconnectBackoff(10*time.Millisecond, 50*time.Millisecond))
+
+	reconnected := make(chan *wstypes.ReconnectEvent, 1)
+	cl.OnReconnect(func(event *wstypes.ReconnectEvent) {
+		reconnected <- event
+	})
+
+	require.NoError(t, cl.Connect())
+	defer cl.Disconnect()
+
+	_, err := cl.Subscribe(&subscribe.Request{Streams: []string{"ledger"}})
+	require.NoError(t, err)
+	require.Equal(t, "subscribe", (<-received)["command"])
+
+	select {
+	case event := <-reconnected:
+		require.Equal(t, 1, event.Attempts)
+		require.NoError(t, event.ResubscribeErr)
+	case <-time.After(2 * time.Second):
+		t.Fatal("reconnect event not received")
+	}
+
+	replayed := <-received
+	require.Equal(t, "subscribe", replayed["command"])
+	require.Equal(t, []any{"ledger"}, replayed["streams"])
+}
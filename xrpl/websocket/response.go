@@ -1,6 +1,7 @@
 package websocket
 
 import (
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -12,6 +13,8 @@ type ResponseWarning struct {
 }
 
 // ErrorWebsocketClientXrplResponse represents an error returned by the XRPL WebSocket client.
+//
+// Deprecated: error responses are returned as *xrpl.XRPLError.
 type ErrorWebsocketClientXrplResponse struct {
 	Type    string
 	Request map[string]any
@@ -24,15 +27,18 @@ func (e *ErrorWebsocketClientXrplResponse) Error() string {
 
 // ClientResponse represents a generic XRPL WebSocket client response, including status, result, and warnings.
 type ClientResponse struct {
-	ID        int               `json:"id"`
-	Status    string            `json:"status"`
-	Type      string            `json:"type"`
-	Error     string            `json:"error,omitempty"`
-	Result    map[string]any    `json:"result,omitempty"`
-	Value     map[string]any    `json:"value,omitempty"`
-	Warning   string            `json:"warning,omitempty"`
-	Warnings  []ResponseWarning `json:"warnings,omitempty"`
-	Forwarded bool              `json:"forwarded,omitempty"`
+	ID           int               `json:"id"`
+	Status       string            `json:"status"`
+	Type         string            `json:"type"`
+	Error        string            `json:"error,omitempty"`
+	ErrorCode    int               `json:"error_code,omitempty"`
+	ErrorMessage string            `json:"error_message,omitempty"`
+	Request      map[string]any    `json:"request,omitempty"`
+	Result       map[string]any    `json:"result,omitempty"`
+	Value        map[string]any    `json:"value,omitempty"`
+	Warning      string            `json:"warning,omitempty"`
+	Warnings     []ResponseWarning `json:"warnings,omitempty"`
+	Forwarded    bool              `json:"forwarded,omitempty"`
 }
 
 // GetResult decodes the Result field into the provided variable v using mapstructure.
@@ -48,13 +54,20 @@ func (r *ClientResponse) GetResult(v any) error {
 	return nil
 }
 
-// CheckError checks if the response contains an error and returns an ErrorWebsocketClientXrplResponse if found.
+// CheckError checks if the response contains an error and returns an *xrpl.XRPLError if found.
 func (r *ClientResponse) CheckError() error {
-	if r.Error != "" {
-		return &ErrorWebsocketClientXrplResponse{
-			Type:    r.Error,
-			Request: r.Value,
-		}
+	if r.Error == "" {
+		return nil
+	}
+	request := r.Request
+	if request == nil {
+		request = r.Value
+	}
+	return &xrpl.XRPLError{
+		Token:   r.Error,
+		Code:    r.ErrorCode,
+		Message: r.ErrorMessage,
+		Status:  r.Status,
+		Request: request,
 	}
-	return nil
 }
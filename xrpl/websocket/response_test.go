@@ -0,0 +1,62 @@
+package websocket
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientResponse_CheckError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		res      *ClientResponse
+		expected error
+	}{
+		{
+			name:     "pass - no error",
+			res:      &ClientResponse{Status: "success"},
+			expected: nil,
+		},
+		{
+			name: "pass - error with code, message and request",
+			res: &ClientResponse{
+				Status:       "error",
+				Error:        "actNotFound",
+				ErrorCode:    19,
+				ErrorMessage: "Account not found.",
+				Request:      map[string]any{"command": "account_info"},
+			},
+			expected: &xrpl.XRPLError{
+				Token:   "actNotFound",
+				Code:    19,
+				Message: "Account not found.",
+				Status:  "error",
+				Request: map[string]any{"command": "account_info"},
+			},
+		},
+		{
+			name: "pass - error with request in value",
+			res: &ClientResponse{
+				Error: "invalidParams",
+				Value: map[string]any{"command": "account_info"},
+			},
+			expected: &xrpl.XRPLError{
+				Token:   "invalidParams",
+				Request: map[string]any{"command": "account_info"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.res.CheckError()
+			if tc.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Equal(t, tc.expected, err)
+			require.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package websocket
+
+import (
+	"log/slog"
+	"sync"
+)
+
+// StreamOverflowPolicy defines what the client does with a stream message when the buffer of its handler is full.
+type StreamOverflowPolicy int
+
+const (
+	// StreamOverflowBlock waits until the handler frees a slot in the buffer. No message is lost, but a slow
+	// handler stalls the reading of every other message, including request responses.
+	StreamOverflowBlock StreamOverflowPolicy = iota
+	// StreamOverflowDropOldest discards the oldest buffered message to make room for the new one.
+	StreamOverflowDropOldest
+	// StreamOverflowDropNewest discards the new message.
+	StreamOverflowDropNewest
+	// StreamOverflowError discards the new message and reports an ErrStreamBufferFull error through OnError.
+	StreamOverflowError
+)
+
+// Stream names used to report dropped messages. They match the names used in subscribe requests.
+const (
+	ledgerStream       = "ledger"
+	transactionsStream = "transactions"
+	validationsStream  = "validations"
+	peerStatusStream   = "peer_status"
+	consensusStream    = "consensus"
+	booksStream        = "books"
+	bookChangesStream  = "book_changes"
+	serverStream       = "server"
+	manifestsStream    = "manifests"
+)
+
+// streamMetrics counts the stream messages dropped by the overflow policy, keyed by stream name.
+// The zero value is ready to use and all methods are safe for concurrent use.
+type streamMetrics struct {
+	mu      sync.Mutex
+	dropped map[string]uint64
+}
+
+// drop records a dropped message for the given stream.
+func (m *streamMetrics) drop(stream string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.dropped == nil {
+		m.dropped = make(map[string]uint64)
+	}
+	m.dropped[stream]++
+}
+
+// snapshot returns a copy of the dropped messages count.
+func (m *streamMetrics) snapshot() map[string]uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dropped := make(map[string]uint64, len(m.dropped))
+	for stream, count := range m.dropped {
+		dropped[stream] = count
+	}
+	return dropped
+}
+
+// DroppedStreamMessages returns the number of stream messages dropped because a handler's buffer was full,
+// keyed by stream name ("ledger", "transactions", "books", ...).
+func (c *Client) DroppedStreamMessages() map[string]uint64 {
+	return c.streamMetrics.snapshot()
+}
+
+// deliverStream sends a stream message to the buffered channel of its handler, applying the configured
+// overflow policy when the buffer is full.
+func deliverStream[T any](c *Client, ch chan T, stream string, msg T) {
+	switch c.cfg.streamOverflowPolicy {
+	case StreamOverflowDropOldest:
+		for {
+			select {
+			case ch <- msg:
+				return
+			default:
+			}
+			select {
+			case <-ch:
+				c.streamMetrics.drop(stream)
+				c.cfg.log().Warn("dropped stream message", "stream", stream)
+			default:
+			}
+		}
+	case StreamOverflowDropNewest, StreamOverflowError:
+		select {
+		case ch <- msg:
+			return
+		default:
+		}
+		c.streamMetrics.drop(stream)
+		if c.cfg.streamOverflowPolicy == StreamOverflowError {
+			c.reportError(slog.LevelWarn, "dropped stream message", ErrStreamBufferFull{Stream: stream}, "stream", stream)
+		} else {
+			c.cfg.log().Warn("dropped stream message", "stream", stream)
+		}
+	default:
+		ch <- msg
+	}
+}
@@ -0,0 +1,102 @@
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverStream(t *testing.T) {
+	tests := []struct {
+		name            string
+		policy          StreamOverflowPolicy
+		expectedBuffer  []int
+		expectedDropped map[string]uint64
+		expectedErr     error
+	}{
+		{
+			name:            "drop oldest keeps the newest messages",
+			policy:          StreamOverflowDropOldest,
+			expectedBuffer:  []int{2, 3},
+			expectedDropped: map[string]uint64{ledgerStream: 1},
+		},
+		{
+			name:            "drop newest keeps the oldest messages",
+			policy:          StreamOverflowDropNewest,
+			expectedBuffer:  []int{1, 2},
+			expectedDropped: map[string]uint64{ledgerStream: 1},
+		},
+		{
+			name:            "error drops the newest message and reports it",
+			policy:          StreamOverflowError,
+			expectedBuffer:  []int{1, 2},
+			expectedDropped: map[string]uint64{ledgerStream: 1},
+			expectedErr:     ErrStreamBufferFull{Stream: ledgerStream},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			c := &Client{
+				cfg: NewClientConfig().
+					WithStreamBuffer(2, tt.policy).
+					WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))),
+				errChan: make(chan error, 1),
+			}
+			ch := make(chan int, c.cfg.streamBufferSize)
+
+			for i := 1; i <= 3; i++ {
+				deliverStream(c, ch, ledgerStream, i)
+			}
+			close(ch)
+
+			buffer := []int{}
+			for msg := range ch {
+				buffer = append(buffer, msg)
+			}
+			require.Equal(t, tt.expectedBuffer, buffer)
+			require.Equal(t, tt.expectedDropped, c.DroppedStreamMessages())
+			if tt.expectedErr != nil {
+				require.Equal(t, tt.expectedErr, <-c.errChan)
+			} else {
+				require.Len(t, c.errChan, 0)
+			}
+
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(logs.Bytes(), &record))
+			require.Equal(t, "dropped stream message", record["msg"])
+			require.Equal(t, "WARN", record["level"])
+			require.Equal(t, ledgerStream, record["stream"])
+		})
+	}
+}
+
+func TestDeliverStream_Block(t *testing.T) {
+	c := &Client{cfg: NewClientConfig().WithStreamBuffer(1, StreamOverflowBlock)}
+	ch := make(chan int, c.cfg.streamBufferSize)
+
+	deliverStream(c, ch, ledgerStream, 1)
+	done := make(chan struct{})
+	go func() {
+		deliverStream(c, ch, ledgerStream, 2)
+		close(done)
+	}()
+
+	require.Never(t, func() bool {
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}, 50*time.Millisecond, 5*time.Millisecond, "delivery did not block on a full buffer")
+	require.Equal(t, 1, <-ch)
+	<-done
+	require.Equal(t, 2, <-ch)
+	require.Empty(t, c.DroppedStreamMessages())
+}
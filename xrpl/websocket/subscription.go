@@ -1,14 +1,23 @@
 package websocket
 
 import (
+	"context"
+
 	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
 	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	wstypes "github.com/Peersyst/xrpl-go/xrpl/websocket/types"
 )
 
 // Subscribe subscribes to the streams and accounts specified in the request.
 // It returns a response from the server.
+// Active subscriptions are replayed automatically when the client reconnects.
 func (c *Client) Subscribe(req *subscribe.Request) (*subscribe.Response, error) {
-	res, err := c.Request(req)
+	return c.SubscribeContext(context.Background(), req)
+}
+
+// SubscribeContext is like Subscribe but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) SubscribeContext(ctx context.Context, req *subscribe.Request) (*subscribe.Response, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -17,13 +26,19 @@ func (c *Client) Subscribe(req *subscribe.Request) (*subscribe.Response, error)
 	if err != nil {
 		return nil, err
 	}
+	c.subscriptions.add(req)
 	return &lr, nil
 }
 
 // Unsubscribe unsubscribes from the streams and accounts specified in the request.
 // It returns a response from the server.
 func (c *Client) Unsubscribe(req *subscribe.UnsubscribeRequest) (*subscribe.UnsubscribeResponse, error) {
-	res, err := c.Request(req)
+	return c.UnsubscribeContext(context.Background(), req)
+}
+
+// UnsubscribeContext is like Unsubscribe but uses ctx to cancel the request and propagate deadlines.
+func (c *Client) UnsubscribeContext(ctx context.Context, req *subscribe.UnsubscribeRequest) (*subscribe.UnsubscribeResponse, error) {
+	res, err := c.RequestContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +47,7 @@ func (c *Client) Unsubscribe(req *subscribe.UnsubscribeRequest) (*subscribe.Unsu
 	if err != nil {
 		return nil, err
 	}
+	c.subscriptions.remove(req)
 	return &lr, nil
 }
 
@@ -39,6 +55,8 @@ func (c *Client) Unsubscribe(req *subscribe.UnsubscribeRequest) (*subscribe.Unsu
 
 // OnError handles "error" events.
 // It returns a stream of error streams. Creates a new channel and a goroutine to handle the stream.
+// Errors are buffered for the handler and dropped when the buffer is full, but always logged by the
+// logger set with WithLogger.
 func (c *Client) OnError(
 	errHandler func(err error),
 ) {
@@ -50,6 +68,25 @@ func (c *Client) OnError(
 	}()
 }
 
+// Reconnection
+
+// OnReconnect handles "reconnect" events, emitted after the client reconnects and replays its subscriptions.
+// Creates a new channel and a goroutine to handle the events.
+func (c *Client) OnReconnect(
+	handler func(event *wstypes.ReconnectEvent),
+) {
+	c.reconnectChan = make(chan *wstypes.ReconnectEvent)
+	go func() {
+		defer close(c.reconnectChan)
+		for event := range c.reconnectChan {
+			handler(event)
+		}
+	}()
+}
+
+// Stream handlers receive messages through a buffer sized with WithStreamBuffer. When the buffer is full,
+// the configured StreamOverflowPolicy decides whether the client waits or drops messages.
+
 // Ledger streams
 
 // OnLedgerClosed handles "ledgerClosed" events.
@@ -57,7 +94,7 @@ func (c *Client) OnError(
 func (c *Client) OnLedgerClosed(
 	handler func(ledger *streamtypes.LedgerStream),
 ) {
-	c.ledgerClosedChan = make(chan *streamtypes.LedgerStream)
+	c.ledgerClosedChan = make(chan *streamtypes.LedgerStream, c.cfg.streamBufferSize)
 	go func() {
 		defer close(c.ledgerClosedChan)
 		for ledger := range c.ledgerClosedChan {
@@ -73,7 +110,7 @@ func (c *Client) OnLedgerClosed(
 func (c *Client) OnValidationReceived(
 	handler func(validation *streamtypes.ValidationStream),
 ) {
-	c.validationChan = make(chan *streamtypes.ValidationStream)
+	c.validationChan = make(chan *streamtypes.ValidationStream, c.cfg.streamBufferSize)
 	go func() {
 		defer close(c.validationChan)
 		for validation := range c.validationChan {
@@ -89,7 +126,7 @@ func (c *Client) OnValidationReceived(
 func (c *Client) OnTransactions(
 	handler func(transactions *streamtypes.TransactionStream),
 ) {
-	c.transactionChan = make(chan *streamtypes.TransactionStream)
+	c.transactionChan = make(chan *streamtypes.TransactionStream, c.cfg.streamBufferSize)
 	go func() {
 		defer close(c.transactionChan)
 		for transaction := range c.transactionChan {
@@ -105,7 +142,7 @@ func (c *Client) OnTransactions(
 func (c *Client) OnPeerStatusChange(
 	handler func(peerStatus *streamtypes.PeerStatusStream),
 ) {
-	c.peerStatusChan = make(chan *streamtypes.PeerStatusStream)
+	c.peerStatusChan = make(chan *streamtypes.PeerStatusStream, c.cfg.streamBufferSize)
 	go func() {
 		defer close(c.peerStatusChan)
 		for peerStatus := range c.peerStatusChan {
@@ -118,10 +155,12 @@ func (c *Client) OnPeerStatusChange(
 
 // OnOrderBook handles "orderbook" events.
 // It returns a stream of orderbook streams. Creates a new channel and a goroutine to handle the stream.
+// Transactions affecting offers in a subscribed book are delivered here. They are also delivered to
+// OnTransactions when the client is subscribed to transactions or accounts.
 func (c *Client) OnOrderBook(
 	handler func(orderbook *streamtypes.OrderBookStream),
 ) {
-	c.orderBookChan = make(chan *streamtypes.OrderBookStream)
+	c.orderBookChan = make(chan *streamtypes.OrderBookStream, c.cfg.streamBufferSize)
 	go func() {
 		defer close(c.orderBookChan)
 		for orderbook := range c.orderBookChan {
@@ -137,7 +176,7 @@ func (c *Client) OnOrderBook(
 func (c *Client) OnBookChanges(
 	handler func(bookChanges *streamtypes.BookChangesStream),
 ) {
-	c.bookChangesChan = make(chan *streamtypes.BookChangesStream)
+	c.bookChangesChan = make(chan *streamtypes.BookChangesStream, c.cfg.streamBufferSize)
 	go func() {
 		defer close(c.bookChangesChan)
 		for bookChanges := range c.bookChangesChan {
@@ -154,7 +193,7 @@ func (c *Client) OnConsensusPhase(
 	handler func(consensusPhase *streamtypes.ConsensusStream),
 ) {
 
-	c.consensusChan = make(chan *streamtypes.ConsensusStream)
+	c.consensusChan = make(chan *streamtypes.ConsensusStream, c.cfg.streamBufferSize)
 	go func() {
 		defer close(c.consensusChan)
 		for consensusPhase := range c.consensusChan {
@@ -162,3 +201,35 @@ func (c *Client) OnConsensusPhase(
 		}
 	}()
 }
+
+// Server streams
+
+// OnServerStatus handles "serverStatus" events.
+// It returns a stream of server status streams. Creates a new channel and a goroutine to handle the stream.
+func (c *Client) OnServerStatus(
+	handler func(server *streamtypes.ServerStream),
+) {
+	c.serverChan = make(chan *streamtypes.ServerStream, c.cfg.streamBufferSize)
+	go func() {
+		defer close(c.serverChan)
+		for server := range c.serverChan {
+			handler(server)
+		}
+	}()
+}
+
+// Manifest streams
+
+// OnManifestReceived handles "manifestReceived" events.
+// It returns a stream of manifest streams. Creates a new channel and a goroutine to handle the stream.
+func (c *Client) OnManifestReceived(
+	handler func(manifest *streamtypes.ManifestStream),
+) {
+	c.manifestChan = make(chan *streamtypes.ManifestStream, c.cfg.streamBufferSize)
+	go func() {
+		defer close(c.manifestChan)
+		for manifest := range c.manifestChan {
+			handler(manifest)
+		}
+	}()
+}
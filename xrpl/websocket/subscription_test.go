@@ -56,6 +56,9 @@ func TestClient_Subscribe(t *testing.T) {
 			ws := &testutil.MockWebSocketServer{Msgs: tt.serverMessages}
 			s := ws.TestWebSocketServer(func(c *websocket.Conn) {
 				for _, m := range tt.serverMessages {
+					if _, _, err := c.ReadMessage(); err != nil {
+						return
+					}
 					err := c.WriteJSON(m)
 					if err != nil {
 						t.Errorf("error writing message: %v", err)
@@ -124,6 +127,9 @@ func TestClient_Unsubscribe(t *testing.T) {
 			ws := &testutil.MockWebSocketServer{Msgs: tt.serverMessages}
 			s := ws.TestWebSocketServer(func(c *websocket.Conn) {
 				for _, m := range tt.serverMessages {
+					if _, _, err := c.ReadMessage(); err != nil {
+						return
+					}
 					err := c.WriteJSON(m)
 					if err != nil {
 						t.Errorf("error writing message: %v", err)
@@ -0,0 +1,206 @@
+package websocket
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// activeSubscriptions keeps track of the streams, accounts and order books the client is subscribed to,
+// so they can be replayed after a reconnect.
+// The zero value is ready to use and all methods are safe for concurrent use.
+type activeSubscriptions struct {
+	mu               sync.Mutex
+	streams          []string
+	accounts         []types.Address
+	accountsProposed []types.Address
+	books            []streamtypes.OrderBook
+}
+
+// add records the subscriptions of a successful subscribe request.
+func (s *activeSubscriptions) add(req *subscribe.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.streams = appendMissing(s.streams, req.Streams...)
+	s.accounts = appendMissing(s.accounts, req.Accounts...)
+	s.accountsProposed = appendMissing(s.accountsProposed, req.AccountsProposed...)
+	for _, book := range req.Books {
+		s.books = slices.DeleteFunc(s.books, func(b streamtypes.OrderBook) bool {
+			return sameBook(b.TakerGets, b.TakerPays, book.TakerGets, book.TakerPays)
+		})
+		s.books = append(s.books, book)
+	}
+}
+
+// remove forgets the subscriptions of a successful unsubscribe request.
+func (s *activeSubscriptions) remove(req *subscribe.UnsubscribeRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.streams = slices.DeleteFunc(s.streams, func(st string) bool { return slices.Contains(req.Streams, st) })
+	s.accounts = slices.DeleteFunc(s.accounts, func(a types.Address) bool { return slices.Contains(req.Accounts, a) })
+	s.accountsProposed = slices.DeleteFunc(s.accountsProposed, func(a types.Address) bool {
+		return slices.Contains(req.AccountsProposed, a)
+	})
+	for _, book := range req.Books {
+		s.books = slices.DeleteFunc(s.books, func(b streamtypes.OrderBook) bool {
+			if sameBook(b.TakerGets, b.TakerPays, book.TakerGets, book.TakerPays) {
+				return true
+			}
+			return book.Both && sameBook(b.TakerGets, b.TakerPays, book.TakerPays, book.TakerGets)
+		})
+	}
+}
+
+// request builds a subscribe request replaying every active subscription.
+// It returns nil if there are no active subscriptions.
+func (s *activeSubscriptions) request() *subscribe.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.streams) == 0 && len(s.accounts) == 0 && len(s.accountsProposed) == 0 && len(s.books) == 0 {
+		return nil
+	}
+
+	books := cloneNonEmpty(s.books)
+	for i := range books {
+		// The order book snapshot was already delivered with the original subscription.
+		books[i].Snapshot = false
+	}
+
+	return &subscribe.Request{
+		Streams:          cloneNonEmpty(s.streams),
+		Accounts:         cloneNonEmpty(s.accounts),
+		AccountsProposed: cloneNonEmpty(s.accountsProposed),
+		Books:            books,
+	}
+}
+
+// hasStream reports whether the client is subscribed to the stream.
+func (s *activeSubscriptions) hasStream(stream string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Contains(s.streams, stream)
+}
+
+// hasAccount reports whether the client is subscribed to the transactions of the account.
+func (s *activeSubscriptions) hasAccount(account types.Address) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Contains(s.accounts, account)
+}
+
+// coversTransaction reports whether a transaction message could have been sent because of the active
+// subscriptions, rather than only because of the subscriptions made to wait for a transaction.
+// Accounts are matched against the sender and destination of the transaction.
+func (s *activeSubscriptions) coversTransaction(tx *streamtypes.TransactionStream) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if slices.Contains(s.streams, "transactions") || slices.Contains(s.streams, "transactions_proposed") {
+		return true
+	}
+	for _, field := range []string{"Account", "Destination"} {
+		address, _ := tx.Transaction[field].(string)
+		if address == "" {
+			continue
+		}
+		if slices.Contains(s.accounts, types.Address(address)) || slices.Contains(s.accountsProposed, types.Address(address)) {
+			return true
+		}
+	}
+	return false
+}
+
+// cloneNonEmpty returns a copy of s, or nil if s is empty.
+func cloneNonEmpty[T any](s []T) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	return slices.Clone(s)
+}
+
+// routeTransaction reports whether a transaction message should be delivered to the order book handler
+// and whether it should be delivered to the transactions handler.
+// A transaction belongs to an order book if it creates, modifies or deletes an offer in a subscribed book.
+// It's only kept from the transactions handler when no transaction or account subscription could have sent it.
+func (s *activeSubscriptions) routeTransaction(tx *streamtypes.TransactionStream) (toBooks, toTransactions bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	toBooks = s.affectsBook(tx)
+	if !toBooks {
+		return false, true
+	}
+	hasTransactionSubscriptions := len(s.accounts) > 0 || len(s.accountsProposed) > 0 ||
+		slices.Contains(s.streams, "transactions") || slices.Contains(s.streams, "transactions_proposed")
+	return true, hasTransactionSubscriptions
+}
+
+// affectsBook reports whether a transaction touches an offer in one of the subscribed books.
+// It must be called with the lock held.
+func (s *activeSubscriptions) affectsBook(tx *streamtypes.TransactionStream) bool {
+	if len(s.books) == 0 {
+		return false
+	}
+	for _, node := range tx.Meta.AffectedNodes {
+		var fields map[string]any
+		switch {
+		case node.CreatedNode != nil && node.CreatedNode.LedgerEntryType == ledger.OfferEntry:
+			fields = node.CreatedNode.NewFields
+		case node.ModifiedNode != nil && node.ModifiedNode.LedgerEntryType == ledger.OfferEntry:
+			fields = node.ModifiedNode.FinalFields
+		case node.DeletedNode != nil && node.DeletedNode.LedgerEntryType == ledger.OfferEntry:
+			fields = node.DeletedNode.FinalFields
+		default:
+			continue
+		}
+		gets, pays := offerAsset(fields["TakerGets"]), offerAsset(fields["TakerPays"])
+		for _, book := range s.books {
+			if sameBook(book.TakerGets, book.TakerPays, gets, pays) ||
+				(book.Both && sameBook(book.TakerGets, book.TakerPays, pays, gets)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// offerAsset returns the currency and issuer of an offer amount.
+// XRP amounts are encoded as a string of drops, issued currencies as an object.
+func offerAsset(amount any) types.IssuedCurrencyAmount {
+	switch a := amount.(type) {
+	case string:
+		return types.IssuedCurrencyAmount{Currency: currency.NativeCurrencySymbol}
+	case map[string]any:
+		c, _ := a["currency"].(string)
+		issuer, _ := a["issuer"].(string)
+		return types.IssuedCurrencyAmount{Currency: c, Issuer: types.Address(issuer)}
+	default:
+		return types.IssuedCurrencyAmount{}
+	}
+}
+
+// appendMissing appends the values that are not already in s.
+func appendMissing[T comparable](s []T, values ...T) []T {
+	for _, v := range values {
+		if !slices.Contains(s, v) {
+			s = append(s, v)
+		}
+	}
+	return s
+}
+
+// sameBook reports whether two order books trade the same currencies in the same direction.
+func sameBook(getsA, paysA, getsB, paysB types.IssuedCurrencyAmount) bool {
+	return getsA.Currency == getsB.Currency && getsA.Issuer == getsB.Issuer &&
+		paysA.Currency == paysB.Currency && paysA.Issuer == paysB.Issuer
+}
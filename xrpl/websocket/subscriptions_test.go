@@ -0,0 +1,127 @@
+package websocket
+
+import (
+	"testing"
+
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveSubscriptions(t *testing.T) {
+	usd := types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"}
+	xrp := types.IssuedCurrencyAmount{Currency: "XRP"}
+
+	tests := []struct {
+		name        string
+		subscribe   []*subscribe.Request
+		unsubscribe []*subscribe.UnsubscribeRequest
+		expected    *subscribe.Request
+	}{
+		{
+			name:     "no subscriptions",
+			expected: nil,
+		},
+		{
+			name: "duplicated subscriptions are merged",
+			subscribe: []*subscribe.Request{
+				{Streams: []string{"ledger"}, Accounts: []types.Address{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"}},
+				{Streams: []string{"ledger", "transactions"}, Accounts: []types.Address{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"}},
+			},
+			expected: &subscribe.Request{
+				Streams:  []string{"ledger", "transactions"},
+				Accounts: []types.Address{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"},
+			},
+		},
+		{
+			name: "book snapshots are not replayed",
+			subscribe: []*subscribe.Request{
+				{Books: []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd, Taker: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", Snapshot: true}}},
+			},
+			expected: &subscribe.Request{
+				Books: []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd, Taker: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"}},
+			},
+		},
+		{
+			name: "unsubscribed streams, accounts and books are forgotten",
+			subscribe: []*subscribe.Request{
+				{
+					Streams:          []string{"ledger", "transactions"},
+					AccountsProposed: []types.Address{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"},
+					Books: []streamtypes.OrderBook{
+						{TakerGets: xrp, TakerPays: usd},
+						{TakerGets: usd, TakerPays: xrp},
+					},
+				},
+			},
+			unsubscribe: []*subscribe.UnsubscribeRequest{
+				{
+					Streams:          []string{"ledger"},
+					AccountsProposed: []types.Address{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"},
+					Books:            []subscribe.UnsubscribeOrderBook{{TakerGets: xrp, TakerPays: usd, Both: true}},
+				},
+			},
+			expected: &subscribe.Request{
+				Streams: []string{"transactions"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s activeSubscriptions
+			for _, req := range tt.subscribe {
+				s.add(req)
+			}
+			for _, req := range tt.unsubscribe {
+				s.remove(req)
+			}
+			require.Equal(t, tt.expected, s.request())
+		})
+	}
+}
+
+func TestActiveSubscriptions_coversTransaction(t *testing.T) {
+	tx := &streamtypes.TransactionStream{
+		Transaction: map[string]any{
+			"Account":     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+			"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		subscribe *subscribe.Request
+		expected  bool
+	}{
+		{
+			name:      "transactions stream",
+			subscribe: &subscribe.Request{Streams: []string{"transactions"}},
+			expected:  true,
+		},
+		{
+			name:      "sender account",
+			subscribe: &subscribe.Request{Accounts: []types.Address{"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"}},
+			expected:  true,
+		},
+		{
+			name:      "destination account proposed",
+			subscribe: &subscribe.Request{AccountsProposed: []types.Address{"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"}},
+			expected:  true,
+		},
+		{
+			name:      "other account and streams",
+			subscribe: &subscribe.Request{Streams: []string{"ledger"}, Accounts: []types.Address{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"}},
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s activeSubscriptions
+			s.add(tt.subscribe)
+			require.Equal(t, tt.expected, s.coversTransaction(tx))
+		})
+	}
+}
@@ -2,7 +2,6 @@
 package testutil
 
 import (
-	"log"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -25,7 +24,8 @@ func (ms *MockWebSocketServer) TestWebSocketServer(writeFunc connFn) *httptest.S
 		upgrader.CheckOrigin = func(_ *http.Request) bool { return true }
 		c, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
-			log.Println("Upgrade:", err)
+			// Upgrade has already replied to the client with an HTTP error.
+			return
 		}
 
 		writeFunc(c)
@@ -2,12 +2,9 @@
 package types
 
 import (
-	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/Peersyst/xrpl-go/xrpl"
 )
 
 // SubmitOptions configures transaction submission options over WebSocket, including autofill, wallet and fail-hard.
-type SubmitOptions struct {
-	Autofill bool
-	Wallet   *wallet.Wallet
-	FailHard bool
-}
+// It's shared with the rpc client, see xrpl.SubmitOptions.
+type SubmitOptions = xrpl.SubmitOptions
@@ -0,0 +1,9 @@
+package types
+
+// ReconnectEvent describes a successful reconnection of the websocket client.
+type ReconnectEvent struct {
+	// Attempts is the number of connection attempts it took to reconnect.
+	Attempts int
+	// ResubscribeErr is the error returned when replaying the active subscriptions, if any.
+	ResubscribeErr error
+}
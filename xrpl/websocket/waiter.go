@@ -0,0 +1,274 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/xrpl"
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// txWaiter waits for a single transaction to be validated.
+type txWaiter struct {
+	hash    string
+	account types.Address
+
+	// validated receives the transaction once it's validated.
+	validated chan *requests.TxResponse
+	// ledgerClosed receives the index of every validated ledger, keeping only the latest one.
+	ledgerClosed chan uint32
+	// reconnected is signaled after the client reconnects, as stream messages may have been missed.
+	reconnected chan struct{}
+}
+
+// txWaiters keeps track of the transactions awaited through subscriptions, and of the subscriptions made
+// on their behalf: the accounts submitting them and the ledger stream.
+// The zero value is ready to use and all methods are safe for concurrent use.
+type txWaiters struct {
+	mu sync.Mutex
+	// waiters are keyed by transaction hash. The same transaction can be awaited by several waiters.
+	waiters  map[string][]*txWaiter
+	accounts map[types.Address]int
+	ledger   int
+
+	// subscribeMu serializes the subscribe and unsubscribe requests, so a waiter never relies on a
+	// subscription that is still being made.
+	subscribeMu sync.Mutex
+}
+
+// add registers a waiter and returns the subscriptions it needs that are not already made for other waiters.
+func (w *txWaiters) add(waiter *txWaiter) (accounts []types.Address, ledger bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.waiters == nil {
+		w.waiters = make(map[string][]*txWaiter)
+		w.accounts = make(map[types.Address]int)
+	}
+	w.waiters[waiter.hash] = append(w.waiters[waiter.hash], waiter)
+	if w.accounts[waiter.account] == 0 {
+		accounts = append(accounts, waiter.account)
+	}
+	w.accounts[waiter.account]++
+	w.ledger++
+	return accounts, w.ledger == 1
+}
+
+// remove unregisters a waiter and returns the subscriptions no other waiter needs anymore.
+// Removing a waiter that isn't registered has no effect.
+func (w *txWaiters) remove(waiter *txWaiter) (accounts []types.Address, ledger bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	waiters := w.waiters[waiter.hash]
+	i := slices.Index(waiters, waiter)
+	if i < 0 {
+		return nil, false
+	}
+	if len(waiters) == 1 {
+		delete(w.waiters, waiter.hash)
+	} else {
+		w.waiters[waiter.hash] = slices.Delete(waiters, i, i+1)
+	}
+	w.accounts[waiter.account]--
+	if w.accounts[waiter.account] == 0 {
+		delete(w.accounts, waiter.account)
+		accounts = append(accounts, waiter.account)
+	}
+	w.ledger--
+	return accounts, w.ledger == 0
+}
+
+// subscriptions returns the subscriptions made on behalf of the waiters, to be replayed after a reconnect.
+func (w *txWaiters) subscriptions() (accounts []types.Address, ledger bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for account := range w.accounts {
+		accounts = append(accounts, account)
+	}
+	return accounts, w.ledger > 0
+}
+
+// active reports whether any transaction is awaited.
+func (w *txWaiters) active() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.waiters) > 0
+}
+
+// resolve delivers a validated transaction message to the waiters of its transaction, if any.
+func (w *txWaiters) resolve(tx *streamtypes.TransactionStream, message []byte) {
+	if !tx.Validated {
+		return
+	}
+
+	w.mu.Lock()
+	waiters := slices.Clone(w.waiters[strings.ToUpper(string(tx.Hash))])
+	w.mu.Unlock()
+	if len(waiters) == 0 {
+		return
+	}
+
+	for _, waiter := range waiters {
+		// The transaction stream message has the same fields as the tx response.
+		var res requests.TxResponse
+		if err := json.Unmarshal(message, &res); err != nil {
+			return
+		}
+		select {
+		case waiter.validated <- &res:
+		default:
+		}
+	}
+}
+
+// closeLedger notifies every waiter of a validated ledger.
+func (w *txWaiters) closeLedger(index uint32) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, waiters := range w.waiters {
+		for _, waiter := range waiters {
+			select {
+			case <-waiter.ledgerClosed:
+			default:
+			}
+			waiter.ledgerClosed <- index
+		}
+	}
+}
+
+// reconnect notifies every waiter that the client reconnected.
+func (w *txWaiters) reconnect() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, waiters := range w.waiters {
+		for _, waiter := range waiters {
+			select {
+			case waiter.reconnected <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// watchTransaction registers a waiter for the transaction and subscribes to the account submitting it and to
+// the ledger stream. It must be called before the transaction is submitted, so its validation isn't missed.
+func (c *Client) watchTransaction(ctx context.Context, txHash string, account types.Address) (*txWaiter, error) {
+	waiter := &txWaiter{
+		hash:         strings.ToUpper(txHash),
+		account:      account,
+		validated:    make(chan *requests.TxResponse, 1),
+		ledgerClosed: make(chan uint32, 1),
+		reconnected:  make(chan struct{}, 1),
+	}
+
+	c.txWaiters.subscribeMu.Lock()
+	defer c.txWaiters.subscribeMu.Unlock()
+
+	accounts, ledger := c.txWaiters.add(waiter)
+	req := &subscribe.Request{Accounts: accounts}
+	if ledger {
+		req.Streams = []string{ledgerStream}
+	}
+	if len(req.Accounts) == 0 && len(req.Streams) == 0 {
+		return waiter, nil
+	}
+	if _, err := c.RequestContext(ctx, req); err != nil {
+		c.txWaiters.remove(waiter)
+		return nil, err
+	}
+	return waiter, nil
+}
+
+// unwatchTransaction unregisters the waiter and unsubscribes from what no other waiter or active subscription needs.
+func (c *Client) unwatchTransaction(waiter *txWaiter) {
+	c.txWaiters.subscribeMu.Lock()
+	defer c.txWaiters.subscribeMu.Unlock()
+
+	accounts, ledger := c.txWaiters.remove(waiter)
+	req := &subscribe.UnsubscribeRequest{}
+	for _, account := range accounts {
+		if !c.subscriptions.hasAccount(account) {
+			req.Accounts = append(req.Accounts, account)
+		}
+	}
+	if ledger && !c.subscriptions.hasStream(ledgerStream) {
+		req.Streams = []string{ledgerStream}
+	}
+	if len(req.Accounts) == 0 && len(req.Streams) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.timeout)
+	defer cancel()
+	if _, err := c.RequestContext(ctx, req); err != nil {
+		c.cfg.log().Warn("failed to unsubscribe after waiting for transaction", "hash", waiter.hash, "error", err)
+	}
+}
+
+// waitForTransactionStream waits until the transaction of the waiter is validated, or the last validated
+// ledger reaches lastLedgerSequence. The transaction is only looked up with a tx request after a reconnect,
+// as its validation may have been missed, and once lastLedgerSequence is reached.
+func (c *Client) waitForTransactionStream(ctx context.Context, waiter *txWaiter, lastLedgerSequence uint32) (*requests.TxResponse, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case res := <-waiter.validated:
+			return res, nil
+		case <-waiter.reconnected:
+			res, err := c.lookupValidatedTransaction(ctx, waiter.hash)
+			if err != nil || res != nil {
+				return res, err
+			}
+		case index := <-waiter.ledgerClosed:
+			if index < lastLedgerSequence {
+				continue
+			}
+			// The validation of the transaction may be delivered after the ledger including it.
+			select {
+			case res := <-waiter.validated:
+				return res, nil
+			default:
+			}
+			res, err := c.lookupValidatedTransaction(ctx, waiter.hash)
+			if err != nil {
+				return nil, err
+			}
+			if res == nil {
+				return nil, ErrTransactionNotFound
+			}
+			return res, nil
+		}
+	}
+}
+
+// lookupValidatedTransaction looks the transaction up, returning nil if it isn't validated yet.
+func (c *Client) lookupValidatedTransaction(ctx context.Context, txHash string) (*requests.TxResponse, error) {
+	res, err := c.RequestContext(ctx, &requests.TxRequest{Transaction: txHash})
+	if errors.Is(err, xrpl.ErrTxnNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var txResponse requests.TxResponse
+	if err := res.GetResult(&txResponse); err != nil {
+		return nil, err
+	}
+	if !txResponse.Validated {
+		return nil, nil
+	}
+	return &txResponse, nil
+}
@@ -0,0 +1,202 @@
+package websocket
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SubmitTxBlobAndWait_Subscription(t *testing.T) {
+	blob, err := binarycodec.Encode(transaction.FlatTransaction{
+		"TransactionType":    "Payment",
+		"Account":            "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		"Destination":        "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount":             "1000000",
+		"Fee":                "10",
+		"Sequence":           uint32(1),
+		"LastLedgerSequence": uint32(120),
+		"SigningPubKey":      "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+		"TxnSignature":       "C3D3A4A0C0F5A0B0E5A4F3C1F0B7C9A2D1E4F6A8B0C2D4E6F8A0B2C4D6E8F0A2C3D3A4A0C0F5A0B0E5A4F3C1F0B7C9A2D1E4F6A8B0C2D4E6F8A0B2C4D6E8F0A2",
+	})
+	require.NoError(t, err)
+	txHash, err := hash.SignTxBlob(blob)
+	require.NoError(t, err)
+
+	validatedTx := map[string]any{
+		"type":         "transaction",
+		"hash":         txHash,
+		"ledger_index": 110,
+		"validated":    true,
+		"meta":         map[string]any{"TransactionResult": "tesSUCCESS"},
+		"tx_json":      map[string]any{"Account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"},
+	}
+
+	tests := []struct {
+		name             string
+		afterSubmit      []map[string]any
+		txResponse       map[string]any
+		expectedCommands []string
+		expectedIndex    uint32
+		expectedErr      error
+	}{
+		{
+			name:             "pass - resolved by the validated transaction message",
+			afterSubmit:      []map[string]any{{"type": "ledgerClosed", "ledger_index": 109}, validatedTx},
+			expectedCommands: []string{"subscribe", "submit", "unsubscribe"},
+			expectedIndex:    110,
+		},
+		{
+			name:             "pass - looked up once the last ledger sequence is validated",
+			afterSubmit:      []map[string]any{{"type": "ledgerClosed", "ledger_index": 120}},
+			txResponse:       map[string]any{"status": "success", "result": map[string]any{"ledger_index": 118, "validated": true}},
+			expectedCommands: []string{"subscribe", "submit", "tx", "unsubscribe"},
+			expectedIndex:    118,
+		},
+		{
+			name:             "fail - last ledger sequence validated without the transaction",
+			afterSubmit:      []map[string]any{{"type": "ledgerClosed", "ledger_index": 120}},
+			txResponse:       map[string]any{"status": "error", "error": "txnNotFound"},
+			expectedCommands: []string{"subscribe", "submit", "tx", "unsubscribe"},
+			expectedErr:      ErrTransactionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var commands []string
+
+			ws := &testutil.MockWebSocketServer{}
+			s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+				for {
+					var req map[string]any
+					if err := c.ReadJSON(&req); err != nil {
+						return
+					}
+					command, _ := req["command"].(string)
+					mu.Lock()
+					commands = append(commands, command)
+					mu.Unlock()
+
+					res := map[string]any{"id": req["id"], "type": "response", "status": "success", "result": map[string]any{}}
+					switch command {
+					case "submit":
+						res["result"] = map[string]any{"engine_result": "tesSUCCESS"}
+					case "tx":
+						res = map[string]any{"id": req["id"], "type": "response"}
+						for k, v := range tt.txResponse {
+							res[k] = v
+						}
+					}
+					if err := c.WriteJSON(res); err != nil {
+						return
+					}
+					if command == "submit" {
+						for _, msg := range tt.afterSubmit {
+							if err := c.WriteJSON(msg); err != nil {
+								return
+							}
+						}
+					}
+				}
+			})
+			defer s.Close()
+
+			url, _ := testutil.ConvertHTTPToWS(s.URL)
+			cl := NewClient(NewClientConfig().WithHost(url).WithTimeout(time.Second))
+			require.NoError(t, cl.Connect())
+			defer cl.Disconnect()
+
+			res, err := cl.SubmitTxBlobAndWait(blob, false)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.True(t, res.Validated)
+				require.Equal(t, tt.expectedIndex, res.LedgerIndex.Uint32())
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, tt.expectedCommands, commands)
+			require.False(t, cl.txWaiters.active())
+		})
+	}
+}
+
+func TestTxWaiters(t *testing.T) {
+	var w txWaiters
+	first := &txWaiter{hash: "A", account: "rAccount", validated: make(chan *requests.TxResponse, 1), ledgerClosed: make(chan uint32, 1)}
+	second := &txWaiter{hash: "B", account: "rAccount", validated: make(chan *requests.TxResponse, 1), ledgerClosed: make(chan uint32, 1)}
+
+	accounts, ledger := w.add(first)
+	require.Equal(t, []types.Address{"rAccount"}, accounts)
+	require.True(t, ledger)
+
+	// The subscriptions of the first waiter are shared.
+	accounts, ledger = w.add(second)
+	require.Empty(t, accounts)
+	require.False(t, ledger)
+
+	w.closeLedger(100)
+	w.closeLedger(101)
+	require.Equal(t, uint32(101), <-first.ledgerClosed)
+	require.Equal(t, uint32(101), <-second.ledgerClosed)
+
+	w.resolve(&streamtypes.TransactionStream{Hash: "b", Validated: true}, []byte(`{"hash": "B", "ledger_index": 5, "validated": true}`))
+	require.Len(t, first.validated, 0)
+	require.Equal(t, uint32(5), (<-second.validated).LedgerIndex.Uint32())
+
+	accounts, ledger = w.remove(first)
+	require.Empty(t, accounts)
+	require.False(t, ledger)
+	accounts, ledger = w.remove(second)
+	require.Equal(t, []types.Address{"rAccount"}, accounts)
+	require.True(t, ledger)
+	require.False(t, w.active())
+}
+
+func TestTxWaiters_DuplicateHash(t *testing.T) {
+	var w txWaiters
+	first := &txWaiter{hash: "A", account: "rAccount", validated: make(chan *requests.TxResponse, 1), ledgerClosed: make(chan uint32, 1)}
+	second := &txWaiter{hash: "A", account: "rAccount", validated: make(chan *requests.TxResponse, 1), ledgerClosed: make(chan uint32, 1)}
+
+	w.add(first)
+	accounts, ledger := w.add(second)
+	require.Empty(t, accounts)
+	require.False(t, ledger)
+
+	// Both waiters of the transaction are notified.
+	w.resolve(&streamtypes.TransactionStream{Hash: "a", Validated: true}, []byte(`{"hash": "A", "ledger_index": 5, "validated": true}`))
+	require.Equal(t, uint32(5), (<-first.validated).LedgerIndex.Uint32())
+	require.Equal(t, uint32(5), (<-second.validated).LedgerIndex.Uint32())
+
+	accounts, ledger = w.remove(first)
+	require.Empty(t, accounts)
+	require.False(t, ledger)
+	require.True(t, w.active())
+
+	// Removing a waiter twice doesn't release the subscriptions of the other one.
+	accounts, ledger = w.remove(first)
+	require.Empty(t, accounts)
+	require.False(t, ledger)
+
+	accounts, ledger = w.remove(second)
+	require.Equal(t, []types.Address{"rAccount"}, accounts)
+	require.True(t, ledger)
+	require.False(t, w.active())
+
+	accounts, ledger = w.subscriptions()
+	require.Empty(t, accounts)
+	require.False(t, ledger)
+}